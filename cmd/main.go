@@ -1,15 +1,19 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	config "task-management/internal/adapter/config"
 	"task-management/internal/adapter/handler/fiber"
 	"task-management/internal/adapter/handler/fiber/middleware"
 	"task-management/internal/adapter/handler/fiber/routes"
+	"task-management/internal/adapter/storage/filestorage"
 	"task-management/internal/adapter/storage/gorm"
 	"task-management/internal/adapter/storage/gorm/repository"
+	"task-management/internal/core/port"
 	"task-management/internal/core/service"
+	"time"
 )
 
 func main() {
@@ -27,23 +31,86 @@ func main() {
 	}
 	fmt.Println("[INFO] Database connection initialized successfully")
 
+	if config.Env.Storage.SignedURLSecret == config.Env.JWT.SecretKey {
+		log.Fatal("STORAGE_SIGNED_URL_SECRET must be different from JWT_SECRET_KEY")
+	}
+
+	fileStorage, err := newFileStorage(config.Env.Storage)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(gormOrm.Trx)
 	authRepo := repository.NewAuthRepository(gormOrm.Trx)
 	organizationRepo := repository.NewOrganizationRepository(gormOrm.Trx)
+	projectRepo := repository.NewProjectRepository(gormOrm.Trx)
+	ticketRepo := repository.NewTicketRepository(gormOrm.Trx)
+	workflowRepo := repository.NewWorkflowRepository(gormOrm.Trx)
+	activityRepo := repository.NewActivityRepository(gormOrm.Trx)
+	commentRepo := repository.NewCommentRepository(gormOrm.Trx)
+	mentionRepo := repository.NewMentionRepository(gormOrm.Trx)
+	watcherRepo := repository.NewWatcherRepository(gormOrm.Trx)
+	attachmentRepo := repository.NewAttachmentRepository(gormOrm.Trx)
+	uploadRepo := repository.NewUploadRepository(gormOrm.Trx)
+	usageRepo := repository.NewUsageRepository(gormOrm.Trx)
+	entitlementRepo := repository.NewEntitlementRepository(gormOrm.Trx)
+	labelRepo := repository.NewLabelRepository(gormOrm.Trx)
+	componentRepo := repository.NewComponentRepository(gormOrm.Trx)
+	versionRepo := repository.NewVersionRepository(gormOrm.Trx)
+	sprintRepo := repository.NewSprintRepository(gormOrm.Trx)
+	boardRepo := repository.NewBoardRepository(gormOrm.Trx)
+	linkRepo := repository.NewTicketLinkRepository(gormOrm.Trx)
+	timeLogRepo := repository.NewTimeLogRepository(gormOrm.Trx)
+	timesheetRepo := repository.NewTimesheetRepository(gormOrm.Trx)
 
 	// Initialize services
+	entitlementService := service.NewEntitlementService(entitlementRepo)
 	userService := service.NewUserService(userRepo)
 	authService := service.NewAuthService(authRepo)
-	organizationService := service.NewOrganizationService(organizationRepo)
+	organizationService := service.NewOrganizationService(organizationRepo, usageRepo)
+	projectService := service.NewProjectService(projectRepo, entitlementService)
+	ticketService := service.NewTicketService(ticketRepo, workflowRepo, activityRepo, mentionRepo, watcherRepo, projectRepo, componentRepo, linkRepo)
+	workflowService := service.NewWorkflowService(workflowRepo, ticketRepo, entitlementService)
+	activityService := service.NewActivityService(activityRepo, ticketRepo)
+	commentService := service.NewCommentService(commentRepo, ticketRepo, activityRepo, mentionRepo, watcherRepo)
+	watcherService := service.NewWatcherService(watcherRepo, ticketRepo, activityRepo)
+	attachmentService := service.NewAttachmentService(attachmentRepo, ticketRepo, activityRepo, usageRepo, fileStorage, config.Env.Storage.MaxUploadSize, config.Env.Storage.SignedURLSecret, time.Duration(config.Env.Storage.SignedURLTTLSeconds)*time.Second)
+	uploadService := service.NewUploadService(uploadRepo, ticketRepo, activityRepo, usageRepo, fileStorage, config.Env.Storage.MaxResumableUploadSize)
+	labelService := service.NewLabelService(labelRepo, ticketRepo, activityRepo)
+	componentService := service.NewComponentService(componentRepo, ticketRepo, activityRepo)
+	versionService := service.NewVersionService(versionRepo, ticketRepo, activityRepo)
+	sprintService := service.NewSprintService(sprintRepo, ticketRepo, activityRepo)
+	boardService := service.NewBoardService(boardRepo, ticketRepo)
+	linkService := service.NewTicketLinkService(linkRepo, ticketRepo, activityRepo)
+	timeLogService := service.NewTimeLogService(timeLogRepo, ticketRepo, activityRepo)
+	timesheetService := service.NewTimesheetService(timesheetRepo)
 
 	// Initialize handlers
 	userHandler := routes.NewUserHandler(userService)
 	authHandler := routes.NewAuthHandler(authService)
-	organizationHandler := routes.NewOrganizationHandler(organizationService)
+	organizationHandler := routes.NewOrganizationHandler(organizationService, entitlementService)
+	projectHandler := routes.NewProjectHandler(projectService)
+	ticketHandler := routes.NewTicketHandler(ticketService)
+	workflowHandler := routes.NewWorkflowHandler(workflowService)
+	activityHandler := routes.NewActivityHandler(activityService)
+	commentHandler := routes.NewCommentHandler(commentService)
+	watcherHandler := routes.NewWatcherHandler(watcherService)
+	attachmentHandler := routes.NewAttachmentHandler(attachmentService)
+	uploadHandler := routes.NewUploadHandler(uploadService)
+	labelHandler := routes.NewLabelHandler(labelService)
+	componentHandler := routes.NewComponentHandler(componentService)
+	versionHandler := routes.NewVersionHandler(versionService)
+	sprintHandler := routes.NewSprintHandler(sprintService)
+	boardHandler := routes.NewBoardHandler(boardService)
+	linkHandler := routes.NewTicketLinkHandler(linkService)
+	timeLogHandler := routes.NewTimeLogHandler(timeLogService)
+	timesheetHandler := routes.NewTimesheetHandler(timesheetService)
 
 	// Initialize middleware
 	mOrganization := middleware.NewOrganizationMiddleware(organizationService)
+	mProject := middleware.NewProjectMiddleware(projectService)
+	mEntitlement := middleware.NewEntitlementMiddleware(entitlementService)
 
 	// Initialize App routes
 	app := httpfiber.NewApp()
@@ -51,7 +118,52 @@ func main() {
 	app.AuthRoutes(authHandler)
 	app.UserRoutes(userHandler, mOrganization)
 	app.OrganizationRoutes(organizationHandler, mOrganization)
+	app.ProjectRoutes(projectHandler, mOrganization, mProject)
+	app.TicketRoutes(ticketHandler, mOrganization, mProject, mEntitlement)
+	app.WorkflowRoutes(workflowHandler, mOrganization, mProject)
+	app.ActivityRoutes(activityHandler, mOrganization, mProject)
+	app.CommentRoutes(commentHandler, mOrganization, mProject)
+	app.WatcherRoutes(watcherHandler, mOrganization, mProject)
+	app.AttachmentRoutes(attachmentHandler, mOrganization, mProject)
+	app.UploadRoutes(uploadHandler, mOrganization, mProject)
+	app.LabelRoutes(labelHandler, mOrganization, mProject)
+	app.ComponentRoutes(componentHandler, mOrganization, mProject)
+	app.VersionRoutes(versionHandler, mOrganization, mProject)
+	app.SprintRoutes(sprintHandler, mOrganization, mProject)
+	app.BoardRoutes(boardHandler, mOrganization, mProject)
+	app.TicketLinkRoutes(linkHandler, mOrganization, mProject)
+	app.TimeLogRoutes(timeLogHandler, mOrganization, mProject)
+	app.TimesheetRoutes(timesheetHandler, mOrganization)
+
+	if config.Env.App.RankRebalanceIntervalMinutes > 0 {
+		rebalancer := service.NewRankRebalancer(ticketRepo, time.Duration(config.Env.App.RankRebalanceIntervalMinutes)*time.Minute)
+		go rebalancer.Run(context.Background())
+	}
+
+	if config.Env.Storage.UploadReapIntervalMinutes > 0 {
+		reaper := service.NewUploadReaper(uploadRepo, fileStorage, time.Duration(config.Env.Storage.UploadReapIntervalMinutes)*time.Minute)
+		go reaper.Run(context.Background())
+	}
 
 	fmt.Println("[INFO] Starting server...")
 	app.Serve(fmt.Sprintf(":%s", config.Env.ApiPort))
 }
+
+// newFileStorage returns the attachment storage backend selected by STORAGE_DRIVER.
+func newFileStorage(cfg config.Storage) (port.FileStorage, error) {
+	switch cfg.Driver {
+	case "local":
+		return filestorage.NewLocalStorage(cfg.LocalPath)
+	case "s3":
+		return filestorage.NewS3Storage(filestorage.S3Options{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			PathStyle: cfg.S3PathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+}
@@ -16,6 +16,7 @@ type Config struct {
 	RabbitMQ
 	Redis
 	Postgre
+	Storage
 }
 
 // App holds the application configuration.
@@ -26,6 +27,9 @@ type App struct {
 	ShutdownTimeout          uint   `env:"API_SHUTDOWN_TIMEOUT_SECONDS,default=30"`
 	AllowedCredentialOrigins string `env:"ALLOWED_CREDENTIAL_ORIGINS"`
 
+	// How often long or duplicated ticket ranks are rebalanced, 0 disables the job
+	RankRebalanceIntervalMinutes int `env:"RANK_REBALANCE_INTERVAL_MINUTES,default=60"`
+
 	LogLevel    string `env:"LOG_LEVEL,default=info"`
 	Development bool   `env:"DEVELOPMENT,default=false"`
 }
@@ -74,6 +78,28 @@ type Redis struct {
 	WriteTimeoutMs int16  `env:"REDIS_WRITE_TIMEOUT,required"`
 }
 
+// Storage holds the configuration for attachment file storage.
+type Storage struct {
+	Driver        string `env:"STORAGE_DRIVER,default=local"` // local or s3
+	LocalPath     string `env:"STORAGE_LOCAL_PATH,default=./static/attachments"`
+	MaxUploadSize int64  `env:"STORAGE_MAX_UPLOAD_SIZE,default=26214400"` // bytes, also the largest resumable upload chunk
+
+	MaxResumableUploadSize int64 `env:"STORAGE_MAX_RESUMABLE_UPLOAD_SIZE,default=2147483648"` // bytes
+	// How often expired resumable uploads and their chunks are removed, 0 disables the job
+	UploadReapIntervalMinutes int `env:"STORAGE_UPLOAD_REAP_INTERVAL_MINUTES,default=60"`
+
+	// Signed download URLs use their own key so a leaked URL key cannot forge JWTs
+	SignedURLSecret     string `env:"STORAGE_SIGNED_URL_SECRET,required"`
+	SignedURLTTLSeconds int    `env:"STORAGE_SIGNED_URL_TTL_SECONDS,default=900"`
+
+	S3Endpoint  string `env:"STORAGE_S3_ENDPOINT"`
+	S3Region    string `env:"STORAGE_S3_REGION,default=us-east-1"`
+	S3Bucket    string `env:"STORAGE_S3_BUCKET"`
+	S3AccessKey string `env:"STORAGE_S3_ACCESS_KEY"`
+	S3SecretKey string `env:"STORAGE_S3_SECRET_KEY"`
+	S3PathStyle bool   `env:"STORAGE_S3_PATH_STYLE,default=true"` // required by MinIO
+}
+
 // LoadConfig loads the application configuration from environment variables and .env file.
 func LoadConfig() {
 	// Try to load .env file, but don't fail if it doesn't exist
@@ -1,8 +1,10 @@
 package httpfiber
 
 import (
+	config "task-management/internal/adapter/config"
 	"task-management/internal/adapter/handler/fiber/middleware"
 	"task-management/internal/adapter/handler/fiber/routes"
+	"task-management/internal/core/domain"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -10,10 +12,18 @@ import (
 type App struct {
 	app  *fiber.App
 	mApp *middleware.App
+
+	project fiber.Router
 }
 
+// multipartOverhead leaves room for the multipart boundaries and form fields
+// around an upload of the maximum size.
+const multipartOverhead = 1 << 20
+
 func NewApp() *App {
-	app := fiber.New()
+	app := fiber.New(fiber.Config{
+		BodyLimit: int(config.Env.Storage.MaxUploadSize) + multipartOverhead,
+	})
 
 	mApp := middleware.NewMiddlewareHandler(app)
 	mApp.SetupGlobalMiddleware()
@@ -60,6 +70,8 @@ func (r *App) UserRoutes(userHandler *routes.UserHandler, mOrganization *middlew
 	users.Use(r.mApp.AuthMiddleware())
 	users.Use(mOrganization.Middleware())
 
+	users.Get("/me/preferences", userHandler.GetMyPreferences)
+	users.Put("/me/preferences/auto-watch", userHandler.UpdateAutoWatch)
 	users.Get("/", mOrganization.MiddlewareWithPermission("IsPreview"), userHandler.GetAllUsers)
 	users.Get("/:id", mOrganization.MiddlewareWithPermission("IsPreview"), userHandler.GetUserByID)
 	users.Put("/:id", mOrganization.MiddlewareWithPermission("CanManageMembers"), userHandler.UpdateUser)
@@ -78,10 +90,320 @@ func (r *App) OrganizationRoutes(organizationHandler *routes.OrganizationHandler
 	{
 		organizations.Get("/", mOrganization.MiddlewareWithPermission("CanViewReports"), organizationHandler.GetOrganization)
 		organizations.Get("/roles", organizationHandler.GetUserRoleInOrganization)
+		organizations.Get("/current/usage", organizationHandler.GetUsage)
+		organizations.Get("/current/plan", organizationHandler.GetPlan)
 		//organizations.Put("/:id", organizationHandler.UpdateOrganization)
 	}
 }
 
+func (r *App) ProjectRoutes(projectHandler *routes.ProjectHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	api := r.app.Group("/api/v1")
+	projects := api.Group("/projects")
+
+	{
+		projects.Get("/", r.mApp.AuthMiddleware(), mOrganization.Middleware(), projectHandler.GetProjects)
+		projects.Post("/", r.mApp.AuthMiddleware(), mOrganization.Middleware(), mOrganization.MiddlewareWithPermission("CanCreateProjects"), projectHandler.CreateProject)
+	}
+
+	project := r.projectGroup(mOrganization, mProject)
+
+	{
+		project.Get("/", projectHandler.GetProjectByID)
+		project.Put("/", mOrganization.MiddlewareWithPermission("CanManageProjects"), projectHandler.UpdateProject)
+		project.Post("/archive", mOrganization.MiddlewareWithPermission("CanManageProjects"), projectHandler.ArchiveProject)
+		project.Delete("/", mOrganization.MiddlewareWithPermission("CanManageProjects"), projectHandler.DeleteProject)
+	}
+
+	{
+		project.Get("/roles", projectHandler.GetProjectRole)
+		project.Get("/members", projectHandler.GetProjectMembers)
+		project.Post("/members", mProject.MiddlewareWithPermission("CanManageMembers"), projectHandler.AddProjectMember)
+		project.Delete("/members/:userId", mProject.MiddlewareWithPermission("CanManageMembers"), projectHandler.RemoveProjectMember)
+	}
+}
+
+func (r *App) TicketRoutes(ticketHandler *routes.TicketHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware, mEntitlement *middleware.EntitlementMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	{
+		project.Get("/statuses", ticketHandler.GetTicketStatuses)
+		project.Get("/ticket-types", ticketHandler.GetTicketTypes)
+		project.Get("/priorities", ticketHandler.GetPriorities)
+	}
+
+	tickets := project.Group("/tickets")
+
+	{
+		tickets.Get("/", mProject.MiddlewareWithPermission("CanViewAllTasks"), ticketHandler.GetTickets)
+		tickets.Post("/", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanCreateTasks"), ticketHandler.CreateTicket)
+		tickets.Get("/:ticketId", mProject.MiddlewareWithPermission("CanViewAllTasks"), ticketHandler.GetTicketByID)
+		tickets.Put("/:ticketId", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), ticketHandler.UpdateTicket)
+		tickets.Delete("/:ticketId", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanDeleteTasks"), ticketHandler.DeleteTicket)
+	}
+
+	{
+		tickets.Get("/:ticketId/transitions", mProject.MiddlewareWithPermission("CanViewAllTasks"), ticketHandler.GetAvailableTransitions)
+		tickets.Post("/:ticketId/transitions", mOrganization.MiddlewareWithPermission("CanManageTasks"), ticketHandler.TransitionTicket)
+		tickets.Post("/:ticketId/rank", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), ticketHandler.RankTicket)
+		tickets.Get("/:ticketId/history", mProject.MiddlewareWithPermission("CanViewAllTasks"), ticketHandler.GetStatusHistory)
+		tickets.Get("/:ticketId/mentions", mProject.MiddlewareWithPermission("CanViewAllTasks"), ticketHandler.GetMentions)
+	}
+
+	{
+		project.Get("/status-history", mOrganization.MiddlewareWithPermission("CanViewReports"), mEntitlement.RequireFeature(domain.FeatureReports), ticketHandler.GetProjectStatusHistory)
+	}
+
+	browse := r.app.Group("/api/v1/browse", r.mApp.AuthMiddleware(), mOrganization.Middleware())
+
+	{
+		browse.Get("/:ticketKey", ticketHandler.BrowseTicket)
+	}
+}
+
+func (r *App) WorkflowRoutes(workflowHandler *routes.WorkflowHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	{
+		project.Get("/resolutions", workflowHandler.GetResolutions)
+	}
+
+	workflow := project.Group("/workflow")
+
+	{
+		workflow.Get("/transitions", workflowHandler.GetTransitions)
+		workflow.Post("/transitions", mProject.MiddlewareWithPermission("CanManageProject"), workflowHandler.CreateTransition)
+		workflow.Delete("/transitions/:transitionId", mProject.MiddlewareWithPermission("CanManageProject"), workflowHandler.DeleteTransition)
+	}
+}
+
+func (r *App) ActivityRoutes(activityHandler *routes.ActivityHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	{
+		project.Get("/activity", mProject.MiddlewareWithPermission("CanViewAllTasks"), activityHandler.GetProjectActivities)
+		project.Get("/tickets/:ticketId/activity", mProject.MiddlewareWithPermission("CanViewAllTasks"), activityHandler.GetTicketActivities)
+	}
+}
+
+func (r *App) CommentRoutes(commentHandler *routes.CommentHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	comments := project.Group("/tickets/:ticketId/comments", mProject.MiddlewareWithPermission("CanViewAllTasks"))
+
+	{
+		comments.Get("/", commentHandler.GetComments)
+		comments.Post("/", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), commentHandler.CreateComment)
+		comments.Put("/:commentId", commentHandler.UpdateComment)
+		comments.Delete("/:commentId", commentHandler.DeleteComment)
+		comments.Get("/:commentId/revisions", commentHandler.GetCommentRevisions)
+	}
+}
+
+func (r *App) WatcherRoutes(watcherHandler *routes.WatcherHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	watchers := project.Group("/tickets/:ticketId/watchers", mProject.MiddlewareWithPermission("CanViewAllTasks"))
+
+	{
+		watchers.Get("/", watcherHandler.GetWatchers)
+		watchers.Post("/me", watcherHandler.Watch)
+		watchers.Delete("/me", watcherHandler.Unwatch)
+	}
+}
+
+func (r *App) AttachmentRoutes(attachmentHandler *routes.AttachmentHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	// Signed URLs carry their own authorization
+	r.app.Get("/api/v1/files/:attachmentId", attachmentHandler.DownloadSignedAttachment)
+
+	attachments := project.Group("/tickets/:ticketId/attachments", mProject.MiddlewareWithPermission("CanViewAllTasks"))
+
+	{
+		attachments.Get("/", attachmentHandler.GetAttachments)
+		attachments.Post("/", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), attachmentHandler.UploadAttachment)
+		attachments.Get("/:attachmentId/download", attachmentHandler.DownloadAttachment)
+		attachments.Get("/:attachmentId/thumbnail", attachmentHandler.DownloadThumbnail)
+		attachments.Get("/:attachmentId/url", attachmentHandler.CreateSignedURL)
+		attachments.Delete("/:attachmentId", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), attachmentHandler.DeleteAttachment)
+	}
+}
+
+func (r *App) UploadRoutes(uploadHandler *routes.UploadHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	uploads := project.Group("/tickets/:ticketId/uploads", mProject.MiddlewareWithPermission("CanViewAllTasks"))
+
+	{
+		uploads.Options("/", uploadHandler.Options)
+		uploads.Post("/", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), uploadHandler.CreateUpload)
+		// HEAD is registered before GET, which would otherwise also answer HEAD requests
+		uploads.Head("/:uploadId", uploadHandler.HeadUpload)
+		uploads.Get("/:uploadId", uploadHandler.GetUpload)
+		uploads.Patch("/:uploadId", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), uploadHandler.PatchUpload)
+		uploads.Delete("/:uploadId", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), uploadHandler.DeleteUpload)
+	}
+}
+
+func (r *App) LabelRoutes(labelHandler *routes.LabelHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	labels := project.Group("/labels")
+
+	{
+		labels.Get("/", labelHandler.GetLabels)
+		labels.Post("/", mProject.MiddlewareWithPermission("CanManageProject"), labelHandler.CreateLabel)
+		labels.Get("/:labelId", labelHandler.GetLabelByID)
+		labels.Put("/:labelId", mProject.MiddlewareWithPermission("CanManageProject"), labelHandler.UpdateLabel)
+		labels.Delete("/:labelId", mProject.MiddlewareWithPermission("CanManageProject"), labelHandler.DeleteLabel)
+		labels.Post("/:labelId/merge", mProject.MiddlewareWithPermission("CanManageProject"), labelHandler.MergeLabels)
+	}
+
+	ticketLabels := project.Group("/tickets/:ticketId/labels", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"))
+
+	{
+		ticketLabels.Post("/:labelId", labelHandler.AddTicketLabel)
+		ticketLabels.Delete("/:labelId", labelHandler.RemoveTicketLabel)
+	}
+}
+
+func (r *App) ComponentRoutes(componentHandler *routes.ComponentHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	components := project.Group("/components")
+
+	{
+		components.Get("/", componentHandler.GetComponents)
+		components.Post("/", mProject.MiddlewareWithPermission("CanManageComponents"), componentHandler.CreateComponent)
+		components.Get("/:componentId", componentHandler.GetComponentByID)
+		components.Put("/:componentId", mProject.MiddlewareWithPermission("CanManageComponents"), componentHandler.UpdateComponent)
+		components.Delete("/:componentId", mProject.MiddlewareWithPermission("CanManageComponents"), componentHandler.DeleteComponent)
+	}
+
+	ticketComponents := project.Group("/tickets/:ticketId/components", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"))
+
+	{
+		ticketComponents.Post("/:componentId", componentHandler.AddTicketComponent)
+		ticketComponents.Delete("/:componentId", componentHandler.RemoveTicketComponent)
+	}
+}
+
+func (r *App) VersionRoutes(versionHandler *routes.VersionHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	versions := project.Group("/versions")
+
+	{
+		versions.Get("/", versionHandler.GetVersions)
+		versions.Post("/", mProject.MiddlewareWithPermission("CanManageVersions"), versionHandler.CreateVersion)
+		versions.Get("/:versionId", versionHandler.GetVersionByID)
+		versions.Put("/:versionId", mProject.MiddlewareWithPermission("CanManageVersions"), versionHandler.UpdateVersion)
+		versions.Delete("/:versionId", mProject.MiddlewareWithPermission("CanManageVersions"), versionHandler.DeleteVersion)
+		versions.Post("/:versionId/release", mProject.MiddlewareWithPermission("CanManageVersions"), versionHandler.ReleaseVersion)
+		versions.Get("/:versionId/release-notes", versionHandler.GetReleaseNotes)
+	}
+
+	ticketVersions := project.Group("/tickets/:ticketId/versions", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"))
+
+	{
+		ticketVersions.Post("/", versionHandler.AddTicketVersion)
+		ticketVersions.Delete("/:versionId", versionHandler.RemoveTicketVersion)
+	}
+}
+
+func (r *App) SprintRoutes(sprintHandler *routes.SprintHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	sprints := project.Group("/sprints")
+
+	{
+		sprints.Get("/", sprintHandler.GetSprints)
+		sprints.Post("/", mProject.MiddlewareWithPermission("CanManageProject"), sprintHandler.CreateSprint)
+		sprints.Get("/:sprintId", sprintHandler.GetSprintByID)
+		sprints.Put("/:sprintId", mProject.MiddlewareWithPermission("CanManageProject"), sprintHandler.UpdateSprint)
+		sprints.Delete("/:sprintId", mProject.MiddlewareWithPermission("CanManageProject"), sprintHandler.DeleteSprint)
+		sprints.Post("/:sprintId/start", mProject.MiddlewareWithPermission("CanManageProject"), sprintHandler.StartSprint)
+		sprints.Post("/:sprintId/complete", mProject.MiddlewareWithPermission("CanManageProject"), sprintHandler.CompleteSprint)
+		sprints.Post("/:sprintId/tickets", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), sprintHandler.AddSprintTickets)
+		sprints.Delete("/:sprintId/tickets/:ticketId", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), sprintHandler.RemoveSprintTicket)
+	}
+}
+
+func (r *App) BoardRoutes(boardHandler *routes.BoardHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	boards := project.Group("/boards")
+
+	{
+		boards.Get("/", boardHandler.GetBoards)
+		boards.Post("/", mProject.MiddlewareWithPermission("CanManageProject"), boardHandler.CreateBoard)
+		boards.Get("/:boardId", boardHandler.GetBoardByID)
+		boards.Put("/:boardId", mProject.MiddlewareWithPermission("CanManageProject"), boardHandler.UpdateBoard)
+		boards.Delete("/:boardId", mProject.MiddlewareWithPermission("CanManageProject"), boardHandler.DeleteBoard)
+	}
+}
+
+func (r *App) TicketLinkRoutes(linkHandler *routes.TicketLinkHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	links := project.Group("/tickets/:ticketId/links", mProject.MiddlewareWithPermission("CanViewAllTasks"))
+
+	{
+		links.Get("/", linkHandler.GetTicketLinks)
+		links.Post("/", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), linkHandler.CreateTicketLink)
+		links.Delete("/:linkId", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), linkHandler.DeleteTicketLink)
+	}
+}
+
+func (r *App) TimeLogRoutes(timeLogHandler *routes.TimeLogHandler, mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) {
+	project := r.projectGroup(mOrganization, mProject)
+
+	timeLogs := project.Group("/tickets/:ticketId/time-logs", mProject.MiddlewareWithPermission("CanViewAllTasks"))
+
+	{
+		timeLogs.Get("/", timeLogHandler.GetTimeLogs)
+		timeLogs.Post("/", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), timeLogHandler.CreateTimeLog)
+		timeLogs.Put("/:timeLogId", timeLogHandler.UpdateTimeLog)
+		timeLogs.Delete("/:timeLogId", timeLogHandler.DeleteTimeLog)
+	}
+
+	timer := project.Group("/tickets/:ticketId/timer", mProject.MiddlewareWithPermission("CanViewAllTasks"))
+
+	{
+		timer.Post("/start", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), timeLogHandler.StartTimer)
+		timer.Post("/stop", mOrganization.MiddlewareWithPermission("CanManageTasks"), mProject.MiddlewareWithPermission("CanManageTasks"), timeLogHandler.StopTimer)
+		timer.Delete("/", timeLogHandler.DiscardTimer)
+	}
+
+	// The running timer of the user, whatever its project
+	running := r.app.Group("/api/v1/timer", r.mApp.AuthMiddleware(), mOrganization.Middleware())
+
+	{
+		running.Get("/", timeLogHandler.GetMyTimer)
+	}
+}
+
+func (r *App) TimesheetRoutes(timesheetHandler *routes.TimesheetHandler, mOrganization *middleware.OrganizationMiddleware) {
+	timesheets := r.app.Group("/api/v1/timesheets", r.mApp.AuthMiddleware(), mOrganization.Middleware())
+
+	{
+		timesheets.Get("/", timesheetHandler.GetTimesheets)
+		timesheets.Get("/weeks/:week", timesheetHandler.GetTimesheet)
+		timesheets.Post("/weeks/:week/submit", timesheetHandler.SubmitTimesheet)
+		timesheets.Post("/:timesheetId/approve", mOrganization.MiddlewareWithPermission("CanManageMembers"), timesheetHandler.ApproveTimesheet)
+		timesheets.Post("/:timesheetId/reject", mOrganization.MiddlewareWithPermission("CanManageMembers"), timesheetHandler.RejectTimesheet)
+	}
+}
+
+// projectGroup returns the shared /api/v1/projects/:projectId router so that the
+// auth, organization and project middlewares only run once per request.
+func (r *App) projectGroup(mOrganization *middleware.OrganizationMiddleware, mProject *middleware.ProjectMiddleware) fiber.Router {
+	if r.project == nil {
+		r.project = r.app.Group("/api/v1/projects/:projectId", r.mApp.AuthMiddleware(), mOrganization.Middleware(), mProject.Middleware())
+	}
+	return r.project
+}
+
 func (r *App) Serve(port string) error {
 	return r.app.Listen(port)
 }
@@ -40,8 +40,9 @@ func (m *App) SetupGlobalMiddleware() {
 	// CORS middleware - handles Cross-Origin Resource Sharing
 	m.app.Use(cors.New(cors.Config{
 		AllowOrigins:     "*", // In production, specify exact origins
-		AllowMethods:     "GET,POST,PUT,DELETE,OPTIONS",
-		AllowHeaders:     "Origin,Content-Type,Accept,Authorization",
+		AllowMethods:     "GET,POST,PUT,PATCH,DELETE,HEAD,OPTIONS",
+		AllowHeaders:     "Origin,Content-Type,Accept,Authorization,Tus-Resumable,Upload-Length,Upload-Metadata,Upload-Offset,Upload-Checksum",
+		ExposeHeaders:    "Location,Tus-Resumable,Tus-Version,Tus-Extension,Tus-Max-Size,Tus-Checksum-Algorithm,Upload-Offset,Upload-Length,Upload-Expires",
 		AllowCredentials: false, // Set to false when using wildcard origins
 	}))
 }
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"task-management/internal/adapter/handler/fiber/routes"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type EntitlementMiddleware struct {
+	entitlementService port.EntitlementService
+}
+
+func NewEntitlementMiddleware(entitlementService port.EntitlementService) *EntitlementMiddleware {
+	return &EntitlementMiddleware{
+		entitlementService: entitlementService,
+	}
+}
+
+// RequireFeature rejects the request with 403 when the plan of the current
+// organization does not include the feature. It must run after
+// OrganizationMiddleware.
+func (m *EntitlementMiddleware) RequireFeature(feature string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err := m.entitlementService.CheckFeature(c, feature); err != nil {
+			return routes.ResError(c, err)
+		}
+		return c.Next()
+	}
+}
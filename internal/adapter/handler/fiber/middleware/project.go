@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"strconv"
+	"task-management/internal/adapter/handler/fiber/routes"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ProjectMiddleware struct {
+	projectService port.ProjectService
+}
+
+func NewProjectMiddleware(projectService port.ProjectService) *ProjectMiddleware {
+	return &ProjectMiddleware{
+		projectService: projectService,
+	}
+}
+
+// Middleware resolves the :projectId route param inside the current organization
+// and stores the caller's project role. It must run after OrganizationMiddleware.
+func (m *ProjectMiddleware) Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, ok := c.Locals("user_id").(uint)
+		if !ok {
+			return routes.ResData(c, fiber.StatusUnauthorized, "UNAUTHORIZED", "User ID not found in context", nil)
+		}
+
+		orgRole, ok := c.Locals("user_role").(*domain.OrganizationMemberRole)
+		if !ok {
+			return routes.ResData(c, fiber.StatusInternalServerError, "INTERNAL ERROR", "User role not found in context", nil)
+		}
+
+		projectID, err := strconv.ParseUint(c.Params("projectId"), 10, 32)
+		if err != nil {
+			return routes.ResData(c, fiber.StatusBadRequest, "BAD REQUEST", "projectId must be a valid number", nil)
+		}
+
+		if _, err := m.projectService.GetProjectByID(c, uint(projectID)); err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return routes.ResData(c, fiber.StatusNotFound, "NOT FOUND", "project not found", nil)
+			}
+			return routes.ResData(c, fiber.StatusInternalServerError, "INTERNAL ERROR", "Failed to load project", nil)
+		}
+
+		projectRole, err := m.projectService.GetUserRoleInProjectByID(c, uint(projectID), userID)
+		switch {
+		case err == nil:
+		case err != gorm.ErrRecordNotFound:
+			return routes.ResData(c, fiber.StatusInternalServerError, "INTERNAL ERROR", "Failed to check project access", nil)
+		case orgRole.CanManageProjects:
+			projectRole = domain.ProjectManagerRole()
+		case orgRole.CanViewAllProjects:
+			projectRole = domain.ProjectViewerRole()
+		default:
+			return routes.ResData(c, fiber.StatusForbidden, "FORBIDDEN", "You don't have access to this project", nil)
+		}
+
+		c.Locals("project_id", uint(projectID))
+		c.Locals("project_role", projectRole)
+
+		return c.Next()
+	}
+}
+
+func (m *ProjectMiddleware) MiddlewareWithPermission(permission string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		projectRole, ok := c.Locals("project_role").(*domain.ProjectMemberRole)
+		if !ok {
+			return routes.ResData(c, fiber.StatusInternalServerError, "INTERNAL ERROR", "Project role not found in context", nil)
+		}
+
+		if !m.hasPermission(projectRole, permission) {
+			return routes.ResData(c, fiber.StatusForbidden, "FORBIDDEN", "You don't have permission to perform this action", nil)
+		}
+
+		return c.Next()
+	}
+}
+
+func (m *ProjectMiddleware) hasPermission(role *domain.ProjectMemberRole, permission string) bool {
+	switch permission {
+	case "CanManageProject":
+		return role.CanManageProject
+	case "CanManageMembers":
+		return role.CanManageMembers
+	case "CanCreateTasks":
+		return role.CanCreateTasks
+	case "CanManageTasks":
+		return role.CanManageTasks
+	case "CanDeleteTasks":
+		return role.CanDeleteTasks
+	case "CanViewAllTasks":
+		return role.CanViewAllTasks
+	case "CanManageComponents":
+		return role.CanManageComponents
+	case "CanManageVersions":
+		return role.CanManageVersions
+	default:
+		return false
+	}
+}
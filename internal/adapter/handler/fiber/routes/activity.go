@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ActivityHandler struct {
+	activityService port.ActivityService
+}
+
+func NewActivityHandler(activityService port.ActivityService) *ActivityHandler {
+	return &ActivityHandler{activityService: activityService}
+}
+
+func (h *ActivityHandler) GetTicketActivities(ctx *fiber.Ctx) error {
+	id, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	total, page, limit, activities, err := h.activityService.GetTicketActivities(ctx, ctx.Locals("project_id").(uint), id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", activities, int(total), int(page), int(limit))
+}
+
+func (h *ActivityHandler) GetProjectActivities(ctx *fiber.Ctx) error {
+	total, page, limit, activities, err := h.activityService.GetProjectActivities(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", activities, int(total), int(page), int(limit))
+}
@@ -0,0 +1,171 @@
+package routes
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AttachmentHandler struct {
+	attachmentService port.AttachmentService
+}
+
+func NewAttachmentHandler(attachmentService port.AttachmentService) *AttachmentHandler {
+	return &AttachmentHandler{attachmentService: attachmentService}
+}
+
+func (h *AttachmentHandler) GetAttachments(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	attachments, err := h.attachmentService.GetAttachments(ctx, ctx.Locals("project_id").(uint), ticketID)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", attachments)
+}
+
+func (h *AttachmentHandler) UploadAttachment(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "file is required", nil)
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	defer file.Close()
+
+	req := &domain.UploadAttachmentRequest{
+		Filename: fileHeader.Filename,
+		Size:     fileHeader.Size,
+		Content:  file,
+	}
+
+	attachment, err := h.attachmentService.UploadAttachment(ctx, ctx.Locals("project_id").(uint), ticketID, req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", attachment)
+}
+
+func (h *AttachmentHandler) DownloadAttachment(ctx *fiber.Ctx) error {
+	ticketID, id, err := attachmentParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and attachmentId must be valid numbers", nil)
+	}
+
+	attachment, content, err := h.attachmentService.DownloadAttachment(ctx, ctx.Locals("project_id").(uint), ticketID, id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+
+	return sendFile(ctx, attachment, content, "attachment")
+}
+
+func (h *AttachmentHandler) DownloadThumbnail(ctx *fiber.Ctx) error {
+	ticketID, id, err := attachmentParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and attachmentId must be valid numbers", nil)
+	}
+
+	size := ctx.Query("size", domain.ThumbnailSizeSmall)
+	if size != domain.ThumbnailSizeSmall && size != domain.ThumbnailSizeMedium {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "size must be small or medium", nil)
+	}
+
+	thumbnail, content, err := h.attachmentService.DownloadThumbnail(ctx, ctx.Locals("project_id").(uint), ticketID, id, size)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+
+	ctx.Set(fiber.HeaderContentType, thumbnail.MimeType)
+	ctx.Set("X-Content-Type-Options", "nosniff")
+	ctx.Set(fiber.HeaderCacheControl, "private, max-age=86400")
+	return ctx.SendStream(content, int(thumbnail.FileSize))
+}
+
+func (h *AttachmentHandler) CreateSignedURL(ctx *fiber.Ctx) error {
+	ticketID, id, err := attachmentParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and attachmentId must be valid numbers", nil)
+	}
+
+	signedURL, err := h.attachmentService.CreateSignedURL(ctx, ctx.Locals("project_id").(uint), ticketID, id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+
+	signedURL.URL = ctx.BaseURL() + signedURL.URL
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", signedURL)
+}
+
+// DownloadSignedAttachment serves a signed URL, it is not behind AuthMiddleware.
+// Images are shown inline so they can be used in <img> tags.
+func (h *AttachmentHandler) DownloadSignedAttachment(ctx *fiber.Ctx) error {
+	id, err := strconv.ParseUint(ctx.Params("attachmentId"), 10, 32)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "attachmentId must be a valid number", nil)
+	}
+
+	expires, err := strconv.ParseInt(ctx.Query("expires"), 10, 64)
+	if err != nil {
+		return ResError(ctx, domain.ErrInvalidSignature)
+	}
+
+	attachment, content, err := h.attachmentService.DownloadSignedAttachment(ctx, uint(id), expires, ctx.Query("signature"))
+	if err != nil {
+		return ResError(ctx, err)
+	}
+
+	ctx.Set(fiber.HeaderCacheControl, "private, max-age="+strconv.FormatInt(max(expires-time.Now().Unix(), 0), 10))
+	if strings.HasPrefix(attachment.MimeType, "image/") {
+		return sendFile(ctx, attachment, content, "inline")
+	}
+	return sendFile(ctx, attachment, content, "attachment")
+}
+
+func (h *AttachmentHandler) DeleteAttachment(ctx *fiber.Ctx) error {
+	ticketID, id, err := attachmentParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and attachmentId must be valid numbers", nil)
+	}
+
+	if err := h.attachmentService.DeleteAttachment(ctx, ctx.Locals("project_id").(uint), ticketID, id); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+// sendFile streams the content of an attachment with the given disposition
+// (inline or attachment). Fiber closes the reader once the response is written.
+func sendFile(ctx *fiber.Ctx, attachment *domain.Attachment, content io.ReadCloser, disposition string) error {
+	ctx.Set(fiber.HeaderContentType, attachment.MimeType)
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`%s; filename="%s"; filename*=UTF-8''%s`, disposition, attachment.Filename, url.PathEscape(attachment.Filename)))
+	ctx.Set("X-Content-Type-Options", "nosniff")
+	return ctx.SendStream(content, int(attachment.FileSize))
+}
+
+func attachmentParams(ctx *fiber.Ctx) (uint, uint, error) {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	id, err := strconv.ParseUint(ctx.Params("attachmentId"), 10, 32)
+	return ticketID, uint(id), err
+}
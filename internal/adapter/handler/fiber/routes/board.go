@@ -0,0 +1,99 @@
+package routes
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type BoardHandler struct {
+	boardService port.BoardService
+	validate     *validator.Validate
+}
+
+func NewBoardHandler(boardService port.BoardService) *BoardHandler {
+	return &BoardHandler{
+		boardService: boardService,
+		validate:     validator.New(),
+	}
+}
+
+func (h *BoardHandler) GetBoards(ctx *fiber.Ctx) error {
+	total, page, limit, boards, err := h.boardService.GetBoards(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", boards, int(total), int(page), int(limit))
+}
+
+func (h *BoardHandler) GetBoardByID(ctx *fiber.Ctx) error {
+	id, err := boardIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "boardId must be a valid number", nil)
+	}
+
+	board, err := h.boardService.GetBoardByID(ctx, ctx.Locals("project_id").(uint), id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", board)
+}
+
+func (h *BoardHandler) CreateBoard(ctx *fiber.Ctx) error {
+	var req domain.CreateBoardRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	board, err := h.boardService.CreateBoard(ctx, ctx.Locals("project_id").(uint), &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", board)
+}
+
+func (h *BoardHandler) UpdateBoard(ctx *fiber.Ctx) error {
+	id, err := boardIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "boardId must be a valid number", nil)
+	}
+
+	var req domain.UpdateBoardRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	board, err := h.boardService.UpdateBoard(ctx, ctx.Locals("project_id").(uint), id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", board)
+}
+
+func (h *BoardHandler) DeleteBoard(ctx *fiber.Ctx) error {
+	id, err := boardIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "boardId must be a valid number", nil)
+	}
+
+	if err := h.boardService.DeleteBoard(ctx, ctx.Locals("project_id").(uint), id); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func boardIDParam(ctx *fiber.Ctx) (uint, error) {
+	id, err := strconv.ParseUint(ctx.Params("boardId"), 10, 32)
+	return uint(id), err
+}
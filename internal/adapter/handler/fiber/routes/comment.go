@@ -0,0 +1,113 @@
+package routes
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CommentHandler struct {
+	commentService port.CommentService
+	validate       *validator.Validate
+}
+
+func NewCommentHandler(commentService port.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+		validate:       validator.New(),
+	}
+}
+
+func (h *CommentHandler) GetComments(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	total, page, limit, comments, err := h.commentService.GetComments(ctx, ctx.Locals("project_id").(uint), ticketID)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", comments, int(total), int(page), int(limit))
+}
+
+func (h *CommentHandler) CreateComment(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	var req domain.CreateCommentRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	comment, err := h.commentService.CreateComment(ctx, ctx.Locals("project_id").(uint), ticketID, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", comment)
+}
+
+func (h *CommentHandler) UpdateComment(ctx *fiber.Ctx) error {
+	ticketID, id, err := commentParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and commentId must be valid numbers", nil)
+	}
+
+	var req domain.UpdateCommentRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	comment, err := h.commentService.UpdateComment(ctx, ctx.Locals("project_id").(uint), ticketID, id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", comment)
+}
+
+func (h *CommentHandler) DeleteComment(ctx *fiber.Ctx) error {
+	ticketID, id, err := commentParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and commentId must be valid numbers", nil)
+	}
+
+	if err := h.commentService.DeleteComment(ctx, ctx.Locals("project_id").(uint), ticketID, id); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *CommentHandler) GetCommentRevisions(ctx *fiber.Ctx) error {
+	ticketID, id, err := commentParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and commentId must be valid numbers", nil)
+	}
+
+	revisions, err := h.commentService.GetCommentRevisions(ctx, ctx.Locals("project_id").(uint), ticketID, id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", revisions)
+}
+
+func commentParams(ctx *fiber.Ctx) (uint, uint, error) {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	id, err := strconv.ParseUint(ctx.Params("commentId"), 10, 32)
+	return ticketID, uint(id), err
+}
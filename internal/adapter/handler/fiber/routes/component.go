@@ -0,0 +1,132 @@
+package routes
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ComponentHandler struct {
+	componentService port.ComponentService
+	validate         *validator.Validate
+}
+
+func NewComponentHandler(componentService port.ComponentService) *ComponentHandler {
+	return &ComponentHandler{
+		componentService: componentService,
+		validate:         validator.New(),
+	}
+}
+
+func (h *ComponentHandler) GetComponents(ctx *fiber.Ctx) error {
+	total, page, limit, components, err := h.componentService.GetComponents(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", components, int(total), int(page), int(limit))
+}
+
+func (h *ComponentHandler) GetComponentByID(ctx *fiber.Ctx) error {
+	id, err := componentIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "componentId must be a valid number", nil)
+	}
+
+	component, err := h.componentService.GetComponentByID(ctx, ctx.Locals("project_id").(uint), id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", component)
+}
+
+func (h *ComponentHandler) CreateComponent(ctx *fiber.Ctx) error {
+	var req domain.CreateComponentRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	component, err := h.componentService.CreateComponent(ctx, ctx.Locals("project_id").(uint), &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", component)
+}
+
+func (h *ComponentHandler) UpdateComponent(ctx *fiber.Ctx) error {
+	id, err := componentIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "componentId must be a valid number", nil)
+	}
+
+	var req domain.UpdateComponentRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	component, err := h.componentService.UpdateComponent(ctx, ctx.Locals("project_id").(uint), id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", component)
+}
+
+func (h *ComponentHandler) DeleteComponent(ctx *fiber.Ctx) error {
+	id, err := componentIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "componentId must be a valid number", nil)
+	}
+
+	if err := h.componentService.DeleteComponent(ctx, ctx.Locals("project_id").(uint), id); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *ComponentHandler) AddTicketComponent(ctx *fiber.Ctx) error {
+	ticketID, componentID, err := ticketComponentParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and componentId must be valid numbers", nil)
+	}
+
+	if err := h.componentService.AddTicketComponent(ctx, ctx.Locals("project_id").(uint), ticketID, componentID); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *ComponentHandler) RemoveTicketComponent(ctx *fiber.Ctx) error {
+	ticketID, componentID, err := ticketComponentParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and componentId must be valid numbers", nil)
+	}
+
+	if err := h.componentService.RemoveTicketComponent(ctx, ctx.Locals("project_id").(uint), ticketID, componentID); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func componentIDParam(ctx *fiber.Ctx) (uint, error) {
+	id, err := strconv.ParseUint(ctx.Params("componentId"), 10, 32)
+	return uint(id), err
+}
+
+func ticketComponentParams(ctx *fiber.Ctx) (uint, uint, error) {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	componentID, err := componentIDParam(ctx)
+	return ticketID, componentID, err
+}
@@ -0,0 +1,154 @@
+package routes
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type LabelHandler struct {
+	labelService port.LabelService
+	validate     *validator.Validate
+}
+
+func NewLabelHandler(labelService port.LabelService) *LabelHandler {
+	return &LabelHandler{
+		labelService: labelService,
+		validate:     validator.New(),
+	}
+}
+
+func (h *LabelHandler) GetLabels(ctx *fiber.Ctx) error {
+	total, page, limit, labels, err := h.labelService.GetLabels(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", labels, int(total), int(page), int(limit))
+}
+
+func (h *LabelHandler) GetLabelByID(ctx *fiber.Ctx) error {
+	id, err := labelIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "labelId must be a valid number", nil)
+	}
+
+	label, err := h.labelService.GetLabelByID(ctx, ctx.Locals("project_id").(uint), id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", label)
+}
+
+func (h *LabelHandler) CreateLabel(ctx *fiber.Ctx) error {
+	var req domain.CreateLabelRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	label, err := h.labelService.CreateLabel(ctx, ctx.Locals("project_id").(uint), &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", label)
+}
+
+func (h *LabelHandler) UpdateLabel(ctx *fiber.Ctx) error {
+	id, err := labelIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "labelId must be a valid number", nil)
+	}
+
+	var req domain.UpdateLabelRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	label, err := h.labelService.UpdateLabel(ctx, ctx.Locals("project_id").(uint), id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", label)
+}
+
+func (h *LabelHandler) DeleteLabel(ctx *fiber.Ctx) error {
+	id, err := labelIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "labelId must be a valid number", nil)
+	}
+
+	if err := h.labelService.DeleteLabel(ctx, ctx.Locals("project_id").(uint), id); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *LabelHandler) MergeLabels(ctx *fiber.Ctx) error {
+	id, err := labelIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "labelId must be a valid number", nil)
+	}
+
+	var req domain.MergeLabelsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	label, err := h.labelService.MergeLabels(ctx, ctx.Locals("project_id").(uint), id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", label)
+}
+
+func (h *LabelHandler) AddTicketLabel(ctx *fiber.Ctx) error {
+	ticketID, labelID, err := ticketLabelParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and labelId must be valid numbers", nil)
+	}
+
+	if err := h.labelService.AddTicketLabel(ctx, ctx.Locals("project_id").(uint), ticketID, labelID); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *LabelHandler) RemoveTicketLabel(ctx *fiber.Ctx) error {
+	ticketID, labelID, err := ticketLabelParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and labelId must be valid numbers", nil)
+	}
+
+	if err := h.labelService.RemoveTicketLabel(ctx, ctx.Locals("project_id").(uint), ticketID, labelID); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func labelIDParam(ctx *fiber.Ctx) (uint, error) {
+	id, err := strconv.ParseUint(ctx.Params("labelId"), 10, 32)
+	return uint(id), err
+}
+
+func ticketLabelParams(ctx *fiber.Ctx) (uint, uint, error) {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	labelID, err := labelIDParam(ctx)
+	return ticketID, labelID, err
+}
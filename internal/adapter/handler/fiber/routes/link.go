@@ -0,0 +1,78 @@
+package routes
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TicketLinkHandler struct {
+	linkService port.TicketLinkService
+	validate    *validator.Validate
+}
+
+func NewTicketLinkHandler(linkService port.TicketLinkService) *TicketLinkHandler {
+	return &TicketLinkHandler{
+		linkService: linkService,
+		validate:    validator.New(),
+	}
+}
+
+func (h *TicketLinkHandler) GetTicketLinks(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	links, err := h.linkService.GetTicketLinks(ctx, ctx.Locals("project_id").(uint), ticketID)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", links)
+}
+
+func (h *TicketLinkHandler) CreateTicketLink(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	var req domain.CreateTicketLinkRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	link, err := h.linkService.CreateTicketLink(ctx, ctx.Locals("project_id").(uint), ticketID, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", link)
+}
+
+func (h *TicketLinkHandler) DeleteTicketLink(ctx *fiber.Ctx) error {
+	ticketID, linkID, err := ticketLinkParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and linkId must be valid numbers", nil)
+	}
+
+	if err := h.linkService.DeleteTicketLink(ctx, ctx.Locals("project_id").(uint), ticketID, linkID); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func ticketLinkParams(ctx *fiber.Ctx) (uint, uint, error) {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	linkID, err := strconv.ParseUint(ctx.Params("linkId"), 10, 32)
+	return ticketID, uint(linkID), err
+}
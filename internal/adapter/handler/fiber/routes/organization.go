@@ -11,12 +11,14 @@ import (
 
 type OrganizationHandler struct {
 	organizationService port.OrganizationService
+	entitlementService  port.EntitlementService
 	validate            *validator.Validate
 }
 
-func NewOrganizationHandler(organizationService port.OrganizationService) *OrganizationHandler {
+func NewOrganizationHandler(organizationService port.OrganizationService, entitlementService port.EntitlementService) *OrganizationHandler {
 	return &OrganizationHandler{
 		organizationService: organizationService,
+		entitlementService:  entitlementService,
 		validate:            validator.New(),
 	}
 }
@@ -41,6 +43,26 @@ func (h *OrganizationHandler) GetUserRoleInOrganization(ctx *fiber.Ctx) error {
 
 }
 
+func (h *OrganizationHandler) GetUsage(ctx *fiber.Ctx) error {
+	usage, err := h.organizationService.GetUsage(ctx, ctx.Locals("organization_id").(uint))
+	if err != nil {
+		return ResError(ctx, err)
+	}
+
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", usage)
+}
+
+// GetPlan returns the limits and features of the current organization's plan,
+// the frontend uses it to hide what the plan does not include.
+func (h *OrganizationHandler) GetPlan(ctx *fiber.Ctx) error {
+	plan, err := h.entitlementService.GetPlan(ctx, ctx.Locals("organization_id").(uint))
+	if err != nil {
+		return ResError(ctx, err)
+	}
+
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", plan)
+}
+
 func (h *OrganizationHandler) UpdateOrganization(ctx *fiber.Ctx) error {
 	id, err := strconv.Atoi(ctx.Params("id"))
 
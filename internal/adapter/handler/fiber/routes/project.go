@@ -0,0 +1,128 @@
+package routes
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ProjectHandler struct {
+	projectService port.ProjectService
+	validate       *validator.Validate
+}
+
+func NewProjectHandler(projectService port.ProjectService) *ProjectHandler {
+	return &ProjectHandler{
+		projectService: projectService,
+		validate:       validator.New(),
+	}
+}
+
+func (h *ProjectHandler) GetProjects(ctx *fiber.Ctx) error {
+	total, page, limit, projects, err := h.projectService.GetProjects(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", projects, int(total), int(page), int(limit))
+}
+
+func (h *ProjectHandler) GetProjectByID(ctx *fiber.Ctx) error {
+	project, err := h.projectService.GetProjectByID(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", project)
+}
+
+func (h *ProjectHandler) CreateProject(ctx *fiber.Ctx) error {
+	var req domain.CreateProjectRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	project, err := h.projectService.CreateProject(ctx, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", project)
+}
+
+func (h *ProjectHandler) UpdateProject(ctx *fiber.Ctx) error {
+	var req domain.UpdateProjectRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	project, err := h.projectService.UpdateProject(ctx, ctx.Locals("project_id").(uint), &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", project)
+}
+
+func (h *ProjectHandler) ArchiveProject(ctx *fiber.Ctx) error {
+	project, err := h.projectService.ArchiveProject(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", project)
+}
+
+func (h *ProjectHandler) DeleteProject(ctx *fiber.Ctx) error {
+	if err := h.projectService.DeleteProject(ctx, ctx.Locals("project_id").(uint)); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *ProjectHandler) GetProjectMembers(ctx *fiber.Ctx) error {
+	total, page, limit, members, err := h.projectService.GetProjectMembers(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", members, int(total), int(page), int(limit))
+}
+
+func (h *ProjectHandler) AddProjectMember(ctx *fiber.Ctx) error {
+	var req domain.AddProjectMemberRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	member, err := h.projectService.AddProjectMember(ctx, ctx.Locals("project_id").(uint), &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", member)
+}
+
+func (h *ProjectHandler) RemoveProjectMember(ctx *fiber.Ctx) error {
+	userID, err := strconv.ParseUint(ctx.Params("userId"), 10, 32)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "userId must be a valid number", nil)
+	}
+
+	if err := h.projectService.RemoveProjectMember(ctx, ctx.Locals("project_id").(uint), uint(userID)); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *ProjectHandler) GetProjectRole(ctx *fiber.Ctx) error {
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", ctx.Locals("project_role"))
+}
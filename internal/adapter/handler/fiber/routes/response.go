@@ -1,7 +1,11 @@
 package routes
 
 import (
+	"errors"
+	"task-management/internal/core/domain"
+
 	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
 )
 
 func ResData(ctx *fiber.Ctx, status int, message string, errorText string, data any, optional ...int) error {
@@ -17,3 +21,57 @@ func ResData(ctx *fiber.Ctx, status int, message string, errorText string, data
 		return ctx.Status(status).JSON(rsp)
 	}
 }
+
+// ResError maps errors returned by the services to a response status.
+func ResError(ctx *fiber.Ctx, err error) error {
+	var entitlementErr *domain.EntitlementError
+
+	switch {
+	case errors.As(err, &entitlementErr):
+		return resEntitlementError(ctx, entitlementErr)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ResData(ctx, fiber.StatusNotFound, "NOT FOUND", "record not found", nil)
+	case errors.Is(err, domain.ErrForbidden), errors.Is(err, domain.ErrInvalidSignature):
+		return ResData(ctx, fiber.StatusForbidden, "FORBIDDEN", err.Error(), nil)
+	case errors.Is(err, domain.ErrFileNotFound), errors.Is(err, domain.ErrNoThumbnail):
+		return ResData(ctx, fiber.StatusNotFound, "NOT FOUND", err.Error(), nil)
+	case errors.Is(err, domain.ErrStorageQuotaExceeded):
+		return ResData(ctx, fiber.StatusPaymentRequired, "STORAGE QUOTA EXCEEDED", err.Error(), nil)
+	case errors.Is(err, domain.ErrFileTooLarge):
+		return ResData(ctx, fiber.StatusRequestEntityTooLarge, "REQUEST ENTITY TOO LARGE", err.Error(), nil)
+	case errors.Is(err, domain.ErrLabelExists), errors.Is(err, domain.ErrVersionExists), errors.Is(err, domain.ErrVersionReleased):
+		return ResData(ctx, fiber.StatusConflict, "CONFLICT", err.Error(), nil)
+	case errors.Is(err, domain.ErrUploadOffsetMismatch):
+		return ResData(ctx, fiber.StatusConflict, "CONFLICT", err.Error(), nil)
+	case errors.Is(err, domain.ErrUploadExpired):
+		return ResData(ctx, fiber.StatusGone, "GONE", err.Error(), nil)
+	case errors.Is(err, domain.ErrChecksumMismatch):
+		// 460 is the status defined by the tus checksum extension
+		return ResData(ctx, 460, "CHECKSUM MISMATCH", err.Error(), nil)
+	case errors.Is(err, domain.ErrSprintAlreadyActive), errors.Is(err, domain.ErrSprintNotPlanned), errors.Is(err, domain.ErrSprintNotActive), errors.Is(err, domain.ErrSprintCompleted):
+		return ResData(ctx, fiber.StatusConflict, "CONFLICT", err.Error(), nil)
+	case errors.Is(err, domain.ErrTransitionNotAllowed), errors.Is(err, domain.ErrWIPLimitReached):
+		return ResData(ctx, fiber.StatusConflict, "CONFLICT", err.Error(), nil)
+	case errors.Is(err, domain.ErrLinkExists), errors.Is(err, domain.ErrLinkCycle):
+		return ResData(ctx, fiber.StatusConflict, "CONFLICT", err.Error(), nil)
+	case errors.Is(err, domain.ErrTimerRunning), errors.Is(err, domain.ErrTimerNotRunning):
+		return ResData(ctx, fiber.StatusConflict, "CONFLICT", err.Error(), nil)
+	case errors.Is(err, domain.ErrTimesheetLocked), errors.Is(err, domain.ErrTimesheetNotSubmitted):
+		return ResData(ctx, fiber.StatusConflict, "CONFLICT", err.Error(), nil)
+	default:
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+}
+
+// resEntitlementError sends plan violations with the violated limit or feature
+// as data, limits with 402 and features with 403.
+func resEntitlementError(ctx *fiber.Ctx, err *domain.EntitlementError) error {
+	switch {
+	case err.Feature != "":
+		return ResData(ctx, fiber.StatusForbidden, "FEATURE NOT AVAILABLE", err.Error(), err)
+	case err.Limit == domain.LimitStorage:
+		return ResData(ctx, fiber.StatusPaymentRequired, "STORAGE QUOTA EXCEEDED", err.Error(), err)
+	default:
+		return ResData(ctx, fiber.StatusPaymentRequired, "PLAN LIMIT REACHED", err.Error(), err)
+	}
+}
@@ -0,0 +1,180 @@
+package routes
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SprintHandler struct {
+	sprintService port.SprintService
+	validate      *validator.Validate
+}
+
+func NewSprintHandler(sprintService port.SprintService) *SprintHandler {
+	return &SprintHandler{
+		sprintService: sprintService,
+		validate:      validator.New(),
+	}
+}
+
+func (h *SprintHandler) GetSprints(ctx *fiber.Ctx) error {
+	total, page, limit, sprints, err := h.sprintService.GetSprints(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", sprints, int(total), int(page), int(limit))
+}
+
+func (h *SprintHandler) GetSprintByID(ctx *fiber.Ctx) error {
+	id, err := sprintIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "sprintId must be a valid number", nil)
+	}
+
+	sprint, err := h.sprintService.GetSprintByID(ctx, ctx.Locals("project_id").(uint), id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", sprint)
+}
+
+func (h *SprintHandler) CreateSprint(ctx *fiber.Ctx) error {
+	var req domain.CreateSprintRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	sprint, err := h.sprintService.CreateSprint(ctx, ctx.Locals("project_id").(uint), &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", sprint)
+}
+
+func (h *SprintHandler) UpdateSprint(ctx *fiber.Ctx) error {
+	id, err := sprintIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "sprintId must be a valid number", nil)
+	}
+
+	var req domain.UpdateSprintRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	sprint, err := h.sprintService.UpdateSprint(ctx, ctx.Locals("project_id").(uint), id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", sprint)
+}
+
+func (h *SprintHandler) DeleteSprint(ctx *fiber.Ctx) error {
+	id, err := sprintIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "sprintId must be a valid number", nil)
+	}
+
+	if err := h.sprintService.DeleteSprint(ctx, ctx.Locals("project_id").(uint), id); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *SprintHandler) StartSprint(ctx *fiber.Ctx) error {
+	id, err := sprintIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "sprintId must be a valid number", nil)
+	}
+
+	var req domain.StartSprintRequest
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&req); err != nil {
+			return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+		}
+	}
+
+	sprint, err := h.sprintService.StartSprint(ctx, ctx.Locals("project_id").(uint), id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", sprint)
+}
+
+func (h *SprintHandler) CompleteSprint(ctx *fiber.Ctx) error {
+	id, err := sprintIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "sprintId must be a valid number", nil)
+	}
+
+	var req domain.CompleteSprintRequest
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&req); err != nil {
+			return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+		}
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	completion, err := h.sprintService.CompleteSprint(ctx, ctx.Locals("project_id").(uint), id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", completion)
+}
+
+func (h *SprintHandler) AddSprintTickets(ctx *fiber.Ctx) error {
+	id, err := sprintIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "sprintId must be a valid number", nil)
+	}
+
+	var req domain.SprintTicketsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	if err := h.sprintService.AddSprintTickets(ctx, ctx.Locals("project_id").(uint), id, &req); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *SprintHandler) RemoveSprintTicket(ctx *fiber.Ctx) error {
+	id, err := sprintIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "sprintId must be a valid number", nil)
+	}
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	if err := h.sprintService.RemoveSprintTicket(ctx, ctx.Locals("project_id").(uint), id, ticketID); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func sprintIDParam(ctx *fiber.Ctx) (uint, error) {
+	id, err := strconv.ParseUint(ctx.Params("sprintId"), 10, 32)
+	return uint(id), err
+}
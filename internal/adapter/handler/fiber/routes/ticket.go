@@ -0,0 +1,223 @@
+package routes
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TicketHandler struct {
+	ticketService port.TicketService
+	validate      *validator.Validate
+}
+
+func NewTicketHandler(ticketService port.TicketService) *TicketHandler {
+	return &TicketHandler{
+		ticketService: ticketService,
+		validate:      validator.New(),
+	}
+}
+
+func (h *TicketHandler) GetTickets(ctx *fiber.Ctx) error {
+	total, page, limit, tickets, err := h.ticketService.GetTickets(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", tickets, int(total), int(page), int(limit))
+}
+
+func (h *TicketHandler) GetTicketByID(ctx *fiber.Ctx) error {
+	id, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	ticket, err := h.ticketService.GetTicketByID(ctx, ctx.Locals("project_id").(uint), id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", ticket)
+}
+
+func (h *TicketHandler) BrowseTicket(ctx *fiber.Ctx) error {
+	ticket, err := h.ticketService.BrowseTicket(ctx, ctx.Params("ticketKey"))
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", ticket)
+}
+
+func (h *TicketHandler) CreateTicket(ctx *fiber.Ctx) error {
+	var req domain.CreateTicketRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	ticket, err := h.ticketService.CreateTicket(ctx, ctx.Locals("project_id").(uint), &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", ticket)
+}
+
+func (h *TicketHandler) UpdateTicket(ctx *fiber.Ctx) error {
+	id, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	var req domain.UpdateTicketRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	ticket, err := h.ticketService.UpdateTicket(ctx, ctx.Locals("project_id").(uint), id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", ticket)
+}
+
+func (h *TicketHandler) GetAvailableTransitions(ctx *fiber.Ctx) error {
+	id, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	transitions, err := h.ticketService.GetAvailableTransitions(ctx, ctx.Locals("project_id").(uint), id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", transitions)
+}
+
+func (h *TicketHandler) TransitionTicket(ctx *fiber.Ctx) error {
+	id, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	var req domain.TransitionTicketRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	result, err := h.ticketService.TransitionTicket(ctx, ctx.Locals("project_id").(uint), id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", result)
+}
+
+func (h *TicketHandler) RankTicket(ctx *fiber.Ctx) error {
+	id, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	var req domain.RankTicketRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	ticket, err := h.ticketService.RankTicket(ctx, ctx.Locals("project_id").(uint), id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", ticket)
+}
+
+func (h *TicketHandler) GetStatusHistory(ctx *fiber.Ctx) error {
+	id, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	total, page, limit, history, err := h.ticketService.GetStatusHistory(ctx, ctx.Locals("project_id").(uint), id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", history, int(total), int(page), int(limit))
+}
+
+func (h *TicketHandler) GetProjectStatusHistory(ctx *fiber.Ctx) error {
+	total, page, limit, history, err := h.ticketService.GetProjectStatusHistory(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", history, int(total), int(page), int(limit))
+}
+
+func (h *TicketHandler) GetMentions(ctx *fiber.Ctx) error {
+	id, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	mentions, err := h.ticketService.GetMentions(ctx, ctx.Locals("project_id").(uint), id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", mentions)
+}
+
+func (h *TicketHandler) DeleteTicket(ctx *fiber.Ctx) error {
+	id, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	if err := h.ticketService.DeleteTicket(ctx, ctx.Locals("project_id").(uint), id); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *TicketHandler) GetTicketStatuses(ctx *fiber.Ctx) error {
+	statuses, err := h.ticketService.GetTicketStatuses(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", statuses)
+}
+
+func (h *TicketHandler) GetTicketTypes(ctx *fiber.Ctx) error {
+	types, err := h.ticketService.GetTicketTypes(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", types)
+}
+
+func (h *TicketHandler) GetPriorities(ctx *fiber.Ctx) error {
+	priorities, err := h.ticketService.GetPriorities(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", priorities)
+}
+
+// ticketIDParam parses the :ticketId route param shared by all ticket sub-resources.
+func ticketIDParam(ctx *fiber.Ctx) (uint, error) {
+	id, err := strconv.ParseUint(ctx.Params("ticketId"), 10, 32)
+	return uint(id), err
+}
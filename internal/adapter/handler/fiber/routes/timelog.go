@@ -0,0 +1,160 @@
+package routes
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TimeLogHandler struct {
+	timeLogService port.TimeLogService
+	validate       *validator.Validate
+}
+
+func NewTimeLogHandler(timeLogService port.TimeLogService) *TimeLogHandler {
+	return &TimeLogHandler{
+		timeLogService: timeLogService,
+		validate:       validator.New(),
+	}
+}
+
+func (h *TimeLogHandler) GetTimeLogs(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	total, page, limit, logs, err := h.timeLogService.GetTimeLogs(ctx, ctx.Locals("project_id").(uint), ticketID)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", logs, int(total), int(page), int(limit))
+}
+
+func (h *TimeLogHandler) CreateTimeLog(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	var req domain.CreateTimeLogRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	log, err := h.timeLogService.CreateTimeLog(ctx, ctx.Locals("project_id").(uint), ticketID, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", log)
+}
+
+func (h *TimeLogHandler) UpdateTimeLog(ctx *fiber.Ctx) error {
+	ticketID, logID, err := ticketTimeLogParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and timeLogId must be valid numbers", nil)
+	}
+
+	var req domain.UpdateTimeLogRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	log, err := h.timeLogService.UpdateTimeLog(ctx, ctx.Locals("project_id").(uint), ticketID, logID, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", log)
+}
+
+func (h *TimeLogHandler) DeleteTimeLog(ctx *fiber.Ctx) error {
+	ticketID, logID, err := ticketTimeLogParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and timeLogId must be valid numbers", nil)
+	}
+
+	if err := h.timeLogService.DeleteTimeLog(ctx, ctx.Locals("project_id").(uint), ticketID, logID); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *TimeLogHandler) GetMyTimer(ctx *fiber.Ctx) error {
+	timer, err := h.timeLogService.GetMyTimer(ctx)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", timer)
+}
+
+func (h *TimeLogHandler) StartTimer(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	var req domain.StartTimerRequest
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&req); err != nil {
+			return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+		}
+	}
+
+	timer, err := h.timeLogService.StartTimer(ctx, ctx.Locals("project_id").(uint), ticketID, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", timer)
+}
+
+func (h *TimeLogHandler) StopTimer(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	var req domain.StopTimerRequest
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&req); err != nil {
+			return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+		}
+	}
+
+	log, err := h.timeLogService.StopTimer(ctx, ctx.Locals("project_id").(uint), ticketID, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", log)
+}
+
+func (h *TimeLogHandler) DiscardTimer(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	if err := h.timeLogService.DiscardTimer(ctx, ctx.Locals("project_id").(uint), ticketID); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func ticketTimeLogParams(ctx *fiber.Ctx) (uint, uint, error) {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	logID, err := strconv.ParseUint(ctx.Params("timeLogId"), 10, 32)
+	return ticketID, uint(logID), err
+}
@@ -0,0 +1,117 @@
+package routes
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TimesheetHandler struct {
+	timesheetService port.TimesheetService
+	validate         *validator.Validate
+}
+
+func NewTimesheetHandler(timesheetService port.TimesheetService) *TimesheetHandler {
+	return &TimesheetHandler{
+		timesheetService: timesheetService,
+		validate:         validator.New(),
+	}
+}
+
+func (h *TimesheetHandler) GetTimesheets(ctx *fiber.Ctx) error {
+	userID, err := userIDQuery(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "user_id must be a valid number", nil)
+	}
+
+	total, page, limit, timesheets, err := h.timesheetService.GetTimesheets(ctx, userID, ctx.Query("status"))
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", timesheets, int(total), int(page), int(limit))
+}
+
+func (h *TimesheetHandler) GetTimesheet(ctx *fiber.Ctx) error {
+	week, err := weekParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "week must be a date (YYYY-MM-DD)", nil)
+	}
+
+	userID, err := userIDQuery(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "user_id must be a valid number", nil)
+	}
+
+	timesheet, err := h.timesheetService.GetTimesheet(ctx, userID, week)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", timesheet)
+}
+
+func (h *TimesheetHandler) SubmitTimesheet(ctx *fiber.Ctx) error {
+	week, err := weekParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "week must be a date (YYYY-MM-DD)", nil)
+	}
+
+	timesheet, err := h.timesheetService.SubmitTimesheet(ctx, week)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", timesheet)
+}
+
+func (h *TimesheetHandler) ApproveTimesheet(ctx *fiber.Ctx) error {
+	return h.reviewTimesheet(ctx, h.timesheetService.ApproveTimesheet)
+}
+
+func (h *TimesheetHandler) RejectTimesheet(ctx *fiber.Ctx) error {
+	return h.reviewTimesheet(ctx, h.timesheetService.RejectTimesheet)
+}
+
+func (h *TimesheetHandler) reviewTimesheet(ctx *fiber.Ctx, review func(*fiber.Ctx, uint, *domain.ReviewTimesheetRequest) (*domain.Timesheet, error)) error {
+	id, err := strconv.ParseUint(ctx.Params("timesheetId"), 10, 32)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "timesheetId must be a valid number", nil)
+	}
+
+	var req domain.ReviewTimesheetRequest
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&req); err != nil {
+			return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+		}
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	timesheet, err := review(ctx, uint(id), &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", timesheet)
+}
+
+func weekParam(ctx *fiber.Ctx) (time.Time, error) {
+	return time.Parse(time.DateOnly, ctx.Params("week"))
+}
+
+// userIDQuery returns the optional user_id query parameter
+func userIDQuery(ctx *fiber.Ctx) (*uint, error) {
+	value := ctx.Query("user_id")
+	if value == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	userID := uint(id)
+	return &userID, nil
+}
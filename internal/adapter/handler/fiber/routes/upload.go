@@ -0,0 +1,202 @@
+package routes
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Resumable uploads follow the tus 1.0 protocol (https://tus.io/protocols/resumable-upload)
+const (
+	tusVersion            = "1.0.0"
+	tusExtensions         = "creation,checksum,termination,expiration"
+	tusChecksumAlgorithms = "md5,sha1,sha256"
+	tusChunkContentType   = "application/offset+octet-stream"
+)
+
+type UploadHandler struct {
+	uploadService port.UploadService
+}
+
+func NewUploadHandler(uploadService port.UploadService) *UploadHandler {
+	return &UploadHandler{uploadService: uploadService}
+}
+
+func (h *UploadHandler) Options(ctx *fiber.Ctx) error {
+	ctx.Set("Tus-Resumable", tusVersion)
+	ctx.Set("Tus-Version", tusVersion)
+	ctx.Set("Tus-Extension", tusExtensions)
+	ctx.Set("Tus-Max-Size", strconv.FormatInt(h.uploadService.MaxSize(), 10))
+	ctx.Set("Tus-Checksum-Algorithm", tusChecksumAlgorithms)
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// CreateUpload starts an upload. The file name and the optional sha256 checksum
+// of the whole file are read from the "filename" and "checksum" metadata.
+func (h *UploadHandler) CreateUpload(ctx *fiber.Ctx) error {
+	if !isTusResumable(ctx) {
+		return rejectTusVersion(ctx)
+	}
+
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	length, err := strconv.ParseInt(ctx.Get("Upload-Length"), 10, 64)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Upload-Length must be a valid number", nil)
+	}
+
+	metadata, err := parseUploadMetadata(ctx.Get("Upload-Metadata"))
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+
+	filename := metadata["filename"]
+	if filename == "" {
+		filename = metadata["name"]
+	}
+
+	req := &domain.CreateUploadRequest{
+		Length:   length,
+		Filename: filename,
+		Checksum: metadata["checksum"],
+	}
+
+	upload, err := h.uploadService.CreateUpload(ctx, ctx.Locals("project_id").(uint), ticketID, req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+
+	ctx.Set("Location", ctx.BaseURL()+strings.TrimRight(ctx.Path(), "/")+"/"+upload.UploadID)
+	ctx.Set("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", upload)
+}
+
+func (h *UploadHandler) HeadUpload(ctx *fiber.Ctx) error {
+	ctx.Set("Tus-Resumable", tusVersion)
+	ctx.Set("Cache-Control", "no-store")
+
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ctx.SendStatus(fiber.StatusBadRequest)
+	}
+
+	upload, err := h.uploadService.GetUpload(ctx, ctx.Locals("project_id").(uint), ticketID, ctx.Params("uploadId"))
+	if err != nil {
+		return ResError(ctx, err)
+	}
+
+	ctx.Set("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	ctx.Set("Upload-Length", strconv.FormatInt(upload.Length, 10))
+	if upload.CompletedAt == nil {
+		ctx.Set("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+	}
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+// GetUpload returns the state of an upload as JSON, including the attachment
+// once the upload is completed.
+func (h *UploadHandler) GetUpload(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	upload, err := h.uploadService.GetUpload(ctx, ctx.Locals("project_id").(uint), ticketID, ctx.Params("uploadId"))
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", upload)
+}
+
+func (h *UploadHandler) PatchUpload(ctx *fiber.Ctx) error {
+	if !isTusResumable(ctx) {
+		return rejectTusVersion(ctx)
+	}
+
+	if ctx.Get(fiber.HeaderContentType) != tusChunkContentType {
+		return ResData(ctx, fiber.StatusUnsupportedMediaType, "UNSUPPORTED MEDIA TYPE", "Content-Type must be "+tusChunkContentType, nil)
+	}
+
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	offset, err := strconv.ParseInt(ctx.Get("Upload-Offset"), 10, 64)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Upload-Offset must be a valid number", nil)
+	}
+
+	req := &domain.UploadChunkRequest{
+		Offset:   offset,
+		Checksum: ctx.Get("Upload-Checksum"),
+		Content:  ctx.Body(),
+	}
+
+	upload, err := h.uploadService.WriteChunk(ctx, ctx.Locals("project_id").(uint), ticketID, ctx.Params("uploadId"), req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+
+	ctx.Set("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	if upload.CompletedAt == nil {
+		ctx.Set("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+	}
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *UploadHandler) DeleteUpload(ctx *fiber.Ctx) error {
+	if !isTusResumable(ctx) {
+		return rejectTusVersion(ctx)
+	}
+
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	if err := h.uploadService.DeleteUpload(ctx, ctx.Locals("project_id").(uint), ticketID, ctx.Params("uploadId")); err != nil {
+		return ResError(ctx, err)
+	}
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// isTusResumable sets the Tus-Resumable response header and reports whether the
+// client speaks the supported protocol version.
+func isTusResumable(ctx *fiber.Ctx) bool {
+	ctx.Set("Tus-Resumable", tusVersion)
+	return ctx.Get("Tus-Resumable") == tusVersion
+}
+
+func rejectTusVersion(ctx *fiber.Ctx) error {
+	ctx.Set("Tus-Version", tusVersion)
+	return ResData(ctx, fiber.StatusPreconditionFailed, "PRECONDITION FAILED", "unsupported Tus-Resumable version", nil)
+}
+
+// parseUploadMetadata decodes the Upload-Metadata header: comma separated
+// "key base64(value)" pairs, the value being optional.
+func parseUploadMetadata(header string) (map[string]string, error) {
+	metadata := map[string]string{}
+	if strings.TrimSpace(header) == "" {
+		return metadata, nil
+	}
+
+	for _, pair := range strings.Split(header, ",") {
+		key, encoded, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.New("Upload-Metadata values must be base64 encoded")
+		}
+		metadata[key] = string(value)
+	}
+	return metadata, nil
+}
@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseUploadMetadata(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "empty", header: "", want: map[string]string{}},
+		{name: "blank", header: "  ", want: map[string]string{}},
+		{name: "single pair", header: "filename cmVwb3J0IGZpbmFsLnBkZg==", want: map[string]string{"filename": "report final.pdf"}},
+		{
+			name:   "several pairs",
+			header: "filename cmVwb3J0IGZpbmFsLnBkZg==, filetype YXBwbGljYXRpb24vcGRm",
+			want:   map[string]string{"filename": "report final.pdf", "filetype": "application/pdf"},
+		},
+		{name: "key without value", header: "is_confidential,filetype YXBwbGljYXRpb24vcGRm", want: map[string]string{"is_confidential": "", "filetype": "application/pdf"}},
+		{name: "invalid base64", header: "filename report.pdf", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseUploadMetadata(tt.header)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseUploadMetadata(%q) accepted invalid metadata", tt.header)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseUploadMetadata(%q) error = %v", tt.header, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseUploadMetadata(%q) = %v, want %v", tt.header, got, tt.want)
+			}
+		})
+	}
+}
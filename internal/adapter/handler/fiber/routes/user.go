@@ -73,3 +73,28 @@ func (h *UserHandler) UpdateUser(ctx *fiber.Ctx) error {
 func (h *UserHandler) DeleteUser(ctx *fiber.Ctx) error {
 	return nil
 }
+
+func (h *UserHandler) GetMyPreferences(ctx *fiber.Ctx) error {
+	preferences, err := h.userService.GetMyPreferences(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", preferences)
+}
+
+func (h *UserHandler) UpdateAutoWatch(ctx *fiber.Ctx) error {
+	var req domain.UpdateAutoWatchRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	preference, err := h.userService.UpdateAutoWatch(ctx, &req)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", preference)
+}
@@ -0,0 +1,191 @@
+package routes
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type VersionHandler struct {
+	versionService port.VersionService
+	validate       *validator.Validate
+}
+
+func NewVersionHandler(versionService port.VersionService) *VersionHandler {
+	return &VersionHandler{
+		versionService: versionService,
+		validate:       validator.New(),
+	}
+}
+
+func (h *VersionHandler) GetVersions(ctx *fiber.Ctx) error {
+	total, page, limit, versions, err := h.versionService.GetVersions(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", versions, int(total), int(page), int(limit))
+}
+
+func (h *VersionHandler) GetVersionByID(ctx *fiber.Ctx) error {
+	id, err := versionIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "versionId must be a valid number", nil)
+	}
+
+	version, err := h.versionService.GetVersionByID(ctx, ctx.Locals("project_id").(uint), id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", version)
+}
+
+func (h *VersionHandler) CreateVersion(ctx *fiber.Ctx) error {
+	var req domain.CreateVersionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	version, err := h.versionService.CreateVersion(ctx, ctx.Locals("project_id").(uint), &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", version)
+}
+
+func (h *VersionHandler) UpdateVersion(ctx *fiber.Ctx) error {
+	id, err := versionIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "versionId must be a valid number", nil)
+	}
+
+	var req domain.UpdateVersionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	version, err := h.versionService.UpdateVersion(ctx, ctx.Locals("project_id").(uint), id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", version)
+}
+
+func (h *VersionHandler) DeleteVersion(ctx *fiber.Ctx) error {
+	id, err := versionIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "versionId must be a valid number", nil)
+	}
+
+	if err := h.versionService.DeleteVersion(ctx, ctx.Locals("project_id").(uint), id); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *VersionHandler) ReleaseVersion(ctx *fiber.Ctx) error {
+	id, err := versionIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "versionId must be a valid number", nil)
+	}
+
+	var req domain.ReleaseVersionRequest
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&req); err != nil {
+			return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+		}
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	result, err := h.versionService.ReleaseVersion(ctx, ctx.Locals("project_id").(uint), id, &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", result)
+}
+
+// GetReleaseNotes returns the notes as JSON, or as a Markdown document with
+// ?format=markdown.
+func (h *VersionHandler) GetReleaseNotes(ctx *fiber.Ctx) error {
+	id, err := versionIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "versionId must be a valid number", nil)
+	}
+
+	format := ctx.Query("format", "json")
+	if format != "json" && format != "markdown" {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "format must be json or markdown", nil)
+	}
+
+	notes, err := h.versionService.GetReleaseNotes(ctx, ctx.Locals("project_id").(uint), id)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+
+	if format == "markdown" {
+		ctx.Set(fiber.HeaderContentType, "text/markdown; charset=utf-8")
+		return ctx.Status(fiber.StatusOK).SendString(notes.Markdown())
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", notes)
+}
+
+func (h *VersionHandler) AddTicketVersion(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	var req domain.AddTicketVersionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	if err := h.versionService.AddTicketVersion(ctx, ctx.Locals("project_id").(uint), ticketID, &req); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *VersionHandler) RemoveTicketVersion(ctx *fiber.Ctx) error {
+	ticketID, versionID, err := ticketVersionParams(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId and versionId must be valid numbers", nil)
+	}
+
+	relation := ctx.Query("relation")
+	if err := h.versionService.RemoveTicketVersion(ctx, ctx.Locals("project_id").(uint), ticketID, versionID, relation); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func versionIDParam(ctx *fiber.Ctx) (uint, error) {
+	id, err := strconv.ParseUint(ctx.Params("versionId"), 10, 32)
+	return uint(id), err
+}
+
+func ticketVersionParams(ctx *fiber.Ctx) (uint, uint, error) {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	versionID, err := versionIDParam(ctx)
+	return ticketID, versionID, err
+}
@@ -0,0 +1,52 @@
+package routes
+
+import (
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WatcherHandler struct {
+	watcherService port.WatcherService
+}
+
+func NewWatcherHandler(watcherService port.WatcherService) *WatcherHandler {
+	return &WatcherHandler{watcherService: watcherService}
+}
+
+func (h *WatcherHandler) GetWatchers(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	watchers, err := h.watcherService.GetWatchers(ctx, ctx.Locals("project_id").(uint), ticketID)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", watchers)
+}
+
+func (h *WatcherHandler) Watch(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	if err := h.watcherService.Watch(ctx, ctx.Locals("project_id").(uint), ticketID); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *WatcherHandler) Unwatch(ctx *fiber.Ctx) error {
+	ticketID, err := ticketIDParam(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "ticketId must be a valid number", nil)
+	}
+
+	if err := h.watcherService.Unwatch(ctx, ctx.Locals("project_id").(uint), ticketID); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
@@ -0,0 +1,67 @@
+package routes
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type WorkflowHandler struct {
+	workflowService port.WorkflowService
+	validate        *validator.Validate
+}
+
+func NewWorkflowHandler(workflowService port.WorkflowService) *WorkflowHandler {
+	return &WorkflowHandler{
+		workflowService: workflowService,
+		validate:        validator.New(),
+	}
+}
+
+func (h *WorkflowHandler) GetTransitions(ctx *fiber.Ctx) error {
+	total, page, limit, transitions, err := h.workflowService.GetTransitions(ctx, ctx.Locals("project_id").(uint))
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", transitions, int(total), int(page), int(limit))
+}
+
+func (h *WorkflowHandler) CreateTransition(ctx *fiber.Ctx) error {
+	var req domain.CreateWorkflowTransitionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Invalid request body", nil)
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "Validation failed: "+err.Error(), nil)
+	}
+
+	transition, err := h.workflowService.CreateTransition(ctx, ctx.Locals("project_id").(uint), &req)
+	if err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusCreated, "SUCCESS", "", transition)
+}
+
+func (h *WorkflowHandler) DeleteTransition(ctx *fiber.Ctx) error {
+	id, err := strconv.ParseUint(ctx.Params("transitionId"), 10, 32)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", "transitionId must be a valid number", nil)
+	}
+
+	if err := h.workflowService.DeleteTransition(ctx, ctx.Locals("project_id").(uint), uint(id)); err != nil {
+		return ResError(ctx, err)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", nil)
+}
+
+func (h *WorkflowHandler) GetResolutions(ctx *fiber.Ctx) error {
+	resolutions, err := h.workflowService.GetResolutions(ctx)
+	if err != nil {
+		return ResData(ctx, fiber.StatusBadRequest, "BAD REQUEST", err.Error(), nil)
+	}
+	return ResData(ctx, fiber.StatusOK, "SUCCESS", "", resolutions)
+}
@@ -0,0 +1,17 @@
+package models
+
+type TicketActivity struct {
+	BaseModel
+
+	TicketID  uint    `json:"ticket_id" gorm:"not null;index"`
+	ProjectID uint    `json:"project_id" gorm:"not null;index"`
+	UserID    uint    `json:"user_id" gorm:"not null;index"`
+	Action    string  `json:"action" gorm:"not null;size:20"`
+	Field     string  `json:"field" gorm:"size:50"`
+	OldValue  *string `json:"old_value" gorm:"type:text"`
+	NewValue  *string `json:"new_value" gorm:"type:text"`
+
+	// Relationships
+	Ticket Ticket `json:"ticket" gorm:"foreignKey:TicketID"`
+	User   User   `json:"user" gorm:"foreignKey:UserID"`
+}
@@ -0,0 +1,27 @@
+package models
+
+type Board struct {
+	BaseModel
+
+	ProjectID  uint   `json:"project_id" gorm:"not null;index"`
+	Name       string `json:"name" gorm:"not null;size:100"`
+	SwimlaneBy string `json:"swimlane_by" gorm:"not null;size:20;default:'none'"` // none, assignee, priority or epic
+	WIPMode    string `json:"wip_mode" gorm:"not null;size:20;default:'warn'"`    // warn or enforce
+
+	// Relationships
+	Project Project       `json:"project" gorm:"foreignKey:ProjectID"`
+	Columns []BoardColumn `json:"columns,omitempty" gorm:"foreignKey:BoardID"`
+}
+
+// BoardColumn groups one or more ticket statuses of the project. Columns are
+// replaced as a whole when the board is updated, so they are not soft deleted.
+type BoardColumn struct {
+	ID       uint   `gorm:"primaryKey" json:"id"`
+	BoardID  uint   `json:"board_id" gorm:"not null;index"`
+	Name     string `json:"name" gorm:"not null;size:100"`
+	Position int    `json:"position" gorm:"not null;default:0"`
+	WIPLimit *int   `json:"wip_limit"`
+
+	// Relationships
+	Statuses []TicketStatus `json:"statuses,omitempty" gorm:"many2many:board_column_statuses"`
+}
@@ -15,6 +15,7 @@ func All() ModelList {
 		&UserPreference{},
 		&Organization{},
 		&OrganizationMember{},
+		&OrganizationUsage{},
 		&OrganizationStatus{},
 		&MemberStatus{},
 		&OrganizationMemberRole{},
@@ -24,14 +25,29 @@ func All() ModelList {
 		&ProjectMemberRole{},
 		&Ticket{},
 		&TicketStatus{},
+		&TicketStatusHistory{},
+		&TicketActivity{},
 		&TicketComment{},
+		&TicketCommentRevision{},
+		&TicketMention{},
 		&TicketAttachment{},
+		&AttachmentThumbnail{},
+		&AttachmentUpload{},
 		&Label{},
 		&TimeLog{},
 		&Priority{},
 		&TicketType{},
 		&Component{},
+		&Version{},
+		&TicketVersion{},
+		&Sprint{},
 		&Resolution{},
+		&WorkflowTransition{},
+		&Board{},
+		&BoardColumn{},
+		&TicketLink{},
+		&Timer{},
+		&Timesheet{},
 	}
 }
 
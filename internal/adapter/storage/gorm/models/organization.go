@@ -19,6 +19,16 @@ type Organization struct {
 	Projects []Project            `json:"projects,omitempty" gorm:"foreignKey:OrganizationID"`
 }
 
+// OrganizationUsage tracks what an organization consumes of its plan
+type OrganizationUsage struct {
+	OrganizationID uint      `json:"organization_id" gorm:"primaryKey;autoIncrement:false"`
+	StorageBytes   int64     `json:"storage_bytes" gorm:"not null;default:0"` // size of the attachments
+	UpdatedAt      time.Time `json:"updated_at"`
+
+	// Relationships
+	Organization Organization `json:"organization" gorm:"foreignKey:OrganizationID"`
+}
+
 type OrganizationMember struct {
 	BaseModel
 
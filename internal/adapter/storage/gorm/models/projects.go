@@ -13,6 +13,9 @@ type Project struct {
 	Key            string `json:"key" gorm:"not null;unique;size:10"`
 	OwnerID        uint   `json:"owner_id" gorm:"not null;index"`
 	StatusID       uint   `json:"status_id" gorm:"not null;index;default:1"` // FK to project_statuses (1=active)
+	TicketCounter  uint   `json:"ticket_counter" gorm:"not null;default:0"`  // last number issued to a ticket key
+	// none, component_lead or project_owner
+	AutoAssignMode string `json:"auto_assign_mode" gorm:"not null;size:20;default:'none'"`
 
 	// Relationships
 	Status       ProjectStatus   `json:"status" gorm:"foreignKey:StatusID"`
@@ -71,3 +74,20 @@ type ProjectMemberRole struct {
 	// Relationships
 	ProjectMembers []ProjectMember `json:"project_members,omitempty" gorm:"foreignKey:RoleID"`
 }
+
+type VWProjectMemberRole struct {
+	ProjectID           uint   `json:"project_id" gorm:"primaryKey"`
+	UserID              uint   `json:"user_id"`
+	ID                  uint   `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	IsDefault           bool   `json:"is_default"`
+	CanManageProject    bool   `json:"can_manage_project"`
+	CanManageMembers    bool   `json:"can_manage_members"`
+	CanCreateTasks      bool   `json:"can_create_tasks"`
+	CanManageTasks      bool   `json:"can_manage_tasks"`
+	CanDeleteTasks      bool   `json:"can_delete_tasks"`
+	CanViewAllTasks     bool   `json:"can_view_all_tasks"`
+	CanManageComponents bool   `json:"can_manage_components"`
+	CanManageVersions   bool   `json:"can_manage_versions"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+type Sprint struct {
+	BaseModel
+
+	ProjectID   uint       `json:"project_id" gorm:"not null;index"`
+	Name        string     `json:"name" gorm:"not null;size:100"`
+	Goal        string     `json:"goal" gorm:"type:text"`
+	State       string     `json:"state" gorm:"not null;size:20;default:'planned';index"` // planned, active or completed
+	StartDate   *time.Time `json:"start_date"`
+	EndDate     *time.Time `json:"end_date"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+
+	// Story points of the sprint tickets when it started, and of the resolved
+	// ones when it completed
+	CommittedPoints int `json:"committed_points" gorm:"not null;default:0"`
+	CompletedPoints int `json:"completed_points" gorm:"not null;default:0"`
+
+	// Relationships
+	Project Project  `json:"project" gorm:"foreignKey:ProjectID"`
+	Tickets []Ticket `json:"tickets,omitempty" gorm:"foreignKey:SprintID"`
+}
@@ -11,6 +11,7 @@ type Ticket struct {
 	Title          string     `json:"title" gorm:"not null;size:500"`
 	Description    string     `json:"description" gorm:"type:text"`
 	TicketKey      string     `json:"ticket_key" gorm:"not null;unique;size:20"`
+	TicketNumber   uint       `json:"ticket_number" gorm:"not null;default:0;index"` // sequence within the project
 	TypeID         uint       `json:"type_id" gorm:"not null;index;default:1"`
 	StatusID       uint       `json:"status_id" gorm:"not null;index;default:1"`
 	PriorityID     uint       `json:"priority_id" gorm:"not null;index;default:2"` // FK to priorities (2=medium)
@@ -24,6 +25,12 @@ type Ticket struct {
 	// Story Points for Agile
 	StoryPoints *int `json:"story_points"`
 
+	// Position in the backlog and on boards, ranks compare byte by byte
+	Rank string `json:"rank" gorm:"type:text collate \"C\";not null;default:'';index"`
+
+	// Sprint the ticket is planned in, nil for the backlog
+	SprintID *uint `json:"sprint_id" gorm:"index"`
+
 	// Resolution
 	ResolutionID *uint      `json:"resolution_id" gorm:"index"`
 	ResolvedAt   *time.Time `json:"resolved_at"`
@@ -44,7 +51,7 @@ type Ticket struct {
 	Attachments []TicketAttachment `json:"attachments,omitempty"`
 	Labels      []Label            `json:"labels,omitempty" gorm:"many2many:ticket_labels"`
 	Components  []Component        `json:"components,omitempty" gorm:"many2many:ticket_components"`
-	//Versions    []Version          `json:"versions,omitempty" gorm:"many2many:ticket_versions"`
+	Versions    []TicketVersion    `json:"versions,omitempty" gorm:"foreignKey:TicketID"`
 	Watchers    []User             `json:"watchers,omitempty" gorm:"many2many:ticket_watchers"`
 	TimeLogs    []TimeLog          `json:"time_logs,omitempty"`
 }
@@ -69,16 +76,64 @@ type TicketStatus struct {
 	Tickets []Ticket `json:"tickets,omitempty" gorm:"foreignKey:StatusID"`
 }
 
+type TicketStatusHistory struct {
+	BaseModel
+
+	TicketID     uint      `json:"ticket_id" gorm:"not null;index"`
+	ProjectID    uint      `json:"project_id" gorm:"not null;index"`
+	FromStatusID *uint     `json:"from_status_id" gorm:"index"` // nil when the ticket was created
+	ToStatusID   uint      `json:"to_status_id" gorm:"not null;index"`
+	ChangedBy    uint      `json:"changed_by" gorm:"not null;index"`
+	ChangedAt    time.Time `json:"changed_at" gorm:"not null;index"`
+
+	// Relationships
+	Ticket     Ticket        `json:"ticket" gorm:"foreignKey:TicketID"`
+	FromStatus *TicketStatus `json:"from_status,omitempty" gorm:"foreignKey:FromStatusID"`
+	ToStatus   TicketStatus  `json:"to_status" gorm:"foreignKey:ToStatusID"`
+	User       User          `json:"user" gorm:"foreignKey:ChangedBy"`
+}
+
 type TicketComment struct {
 	BaseModel
 
-	TicketID uint   `json:"ticket_id" gorm:"not null;index"`
-	UserID   uint   `json:"user_id" gorm:"not null;index"`
-	Content  string `json:"content" gorm:"not null;type:text"`
+	TicketID  uint       `json:"ticket_id" gorm:"not null;index"`
+	UserID    uint       `json:"user_id" gorm:"not null;index"`
+	Content   string     `json:"content" gorm:"not null;type:text"`
+	EditedAt  *time.Time `json:"edited_at"`
+	DeletedBy *uint      `json:"deleted_by" gorm:"index"`
 
 	// Relationships
-	Ticket Ticket `json:"ticket" gorm:"foreignKey:TicketID"`
-	User   User   `json:"user" gorm:"foreignKey:UserID"`
+	Ticket    Ticket                  `json:"ticket" gorm:"foreignKey:TicketID"`
+	User      User                    `json:"user" gorm:"foreignKey:UserID"`
+	Revisions []TicketCommentRevision `json:"revisions,omitempty" gorm:"foreignKey:CommentID"`
+}
+
+// TicketCommentRevision keeps the content a comment had before an edit
+type TicketCommentRevision struct {
+	BaseModel
+
+	CommentID uint   `json:"comment_id" gorm:"not null;index"`
+	Content   string `json:"content" gorm:"not null;type:text"`
+	EditedBy  uint   `json:"edited_by" gorm:"not null;index"`
+
+	// Relationships
+	User User `json:"user" gorm:"foreignKey:EditedBy"`
+}
+
+// TicketMention is a user mentioned in a ticket description (CommentID is nil)
+// or in a comment
+type TicketMention struct {
+	BaseModel
+
+	TicketID    uint  `json:"ticket_id" gorm:"not null;index"`
+	CommentID   *uint `json:"comment_id" gorm:"index"`
+	UserID      uint  `json:"user_id" gorm:"not null;index"`
+	MentionedBy uint  `json:"mentioned_by" gorm:"not null;index"`
+
+	// Relationships
+	Ticket  Ticket         `json:"ticket" gorm:"foreignKey:TicketID"`
+	Comment *TicketComment `json:"comment,omitempty" gorm:"foreignKey:CommentID"`
+	User    User           `json:"user" gorm:"foreignKey:UserID"`
 }
 
 type TicketAttachment struct {
@@ -90,10 +145,51 @@ type TicketAttachment struct {
 	FileSize   int64  `json:"file_size" gorm:"not null"`
 	MimeType   string `json:"mime_type" gorm:"size:100"`
 	UploadedBy uint   `json:"uploaded_by" gorm:"not null;index"`
+	Width      *int   `json:"width"` // set for images only
+	Height     *int   `json:"height"`
 
 	// Relationships
-	Ticket   Ticket `json:"ticket" gorm:"foreignKey:TicketID"`
-	Uploader User   `json:"uploader" gorm:"foreignKey:UploadedBy"`
+	Ticket     Ticket                `json:"ticket" gorm:"foreignKey:TicketID"`
+	Uploader   User                  `json:"uploader" gorm:"foreignKey:UploadedBy"`
+	Thumbnails []AttachmentThumbnail `json:"thumbnails,omitempty" gorm:"foreignKey:AttachmentID"`
+}
+
+// AttachmentThumbnail is a downscaled copy of an image attachment
+type AttachmentThumbnail struct {
+	BaseModel
+
+	AttachmentID uint   `json:"attachment_id" gorm:"not null;uniqueIndex:idx_attachment_thumbnail_size"`
+	Size         string `json:"size" gorm:"not null;size:20;uniqueIndex:idx_attachment_thumbnail_size"` // small, medium
+	FilePath     string `json:"file_path" gorm:"not null;size:500"`
+	FileSize     int64  `json:"file_size" gorm:"not null"`
+	MimeType     string `json:"mime_type" gorm:"size:100"`
+	Width        int    `json:"width" gorm:"not null"`
+	Height       int    `json:"height" gorm:"not null"`
+}
+
+// AttachmentUpload tracks a resumable upload until it becomes a TicketAttachment
+type AttachmentUpload struct {
+	BaseModel
+
+	UploadID     string     `json:"upload_id" gorm:"not null;uniqueIndex;size:64"`
+	ProjectID    uint       `json:"project_id" gorm:"not null;index"`
+	TicketID     uint       `json:"ticket_id" gorm:"not null;index"`
+	UserID       uint       `json:"user_id" gorm:"not null;index"`
+	Filename     string     `json:"filename" gorm:"not null;size:255"`
+	Length       int64      `json:"length" gorm:"not null"`
+	UploadOffset int64      `json:"upload_offset" gorm:"not null;default:0"` // bytes received so far
+	ChunkCount   int        `json:"chunk_count" gorm:"not null;default:0"`
+	MimeType     string     `json:"mime_type" gorm:"size:100"`
+	Checksum     string     `json:"checksum" gorm:"size:150"`
+	HashState    []byte     `json:"-"`
+	ExpiresAt    time.Time  `json:"expires_at" gorm:"not null;index"`
+	CompletedAt  *time.Time `json:"completed_at"`
+	AttachmentID *uint      `json:"attachment_id" gorm:"index"`
+
+	// Relationships
+	Ticket     Ticket            `json:"ticket" gorm:"foreignKey:TicketID"`
+	User       User              `json:"user" gorm:"foreignKey:UserID"`
+	Attachment *TicketAttachment `json:"attachment,omitempty" gorm:"foreignKey:AttachmentID"`
 }
 
 type Label struct {
@@ -147,6 +243,10 @@ type TicketType struct {
 	IsActive    bool   `json:"is_active" gorm:"default:true"`
 	Position    int    `json:"position" gorm:"default:0;index"`
 
+	// The parent of a ticket must be exactly one level above it:
+	// epic (3) > story, task or bug (2) > subtask (1)
+	HierarchyLevel int `json:"hierarchy_level" gorm:"not null;default:2"`
+
 	// Relationships
 	Tickets []Ticket `json:"tickets,omitempty" gorm:"foreignKey:TypeID"`
 }
@@ -166,20 +266,49 @@ type Component struct {
 	Tickets []Ticket `json:"tickets,omitempty" gorm:"many2many:ticket_components"`
 }
 
-// type Version struct {
-// 	BaseModel
+type Version struct {
+	BaseModel
+
+	ProjectID   uint       `json:"project_id" gorm:"not null;index"`
+	Name        string     `json:"name" gorm:"not null;size:100"`
+	Description string     `json:"description" gorm:"type:text"`
+	ReleaseDate *time.Time `json:"release_date"`
+	IsReleased  bool       `json:"is_released" gorm:"default:false"`
+	IsActive    bool       `json:"is_active" gorm:"default:true"`
+	ReleasedAt  *time.Time `json:"released_at"`
+	ReleasedBy  *uint      `json:"released_by"`
+
+	// Relationships
+	Project Project         `json:"project" gorm:"foreignKey:ProjectID"`
+	Tickets []TicketVersion `json:"tickets,omitempty" gorm:"foreignKey:VersionID"`
+}
+
+// TicketVersion links a ticket to a version it is fixed in or that it affects
+type TicketVersion struct {
+	TicketID  uint      `json:"ticket_id" gorm:"primaryKey"`
+	VersionID uint      `json:"version_id" gorm:"primaryKey;index"`
+	Relation  string    `json:"relation" gorm:"primaryKey;size:10"` // fix or affects
+	CreatedAt time.Time `json:"created_at"`
 
-// 	ProjectID   uint       `json:"project_id" gorm:"not null;index"`
-// 	Name        string     `json:"name" gorm:"not null;size:100"`
-// 	Description string     `json:"description" gorm:"type:text"`
-// 	ReleaseDate *time.Time `json:"release_date"`
-// 	IsReleased  bool       `json:"is_released" gorm:"default:false"`
-// 	IsActive    bool       `json:"is_active" gorm:"default:true"`
+	// Relationships
+	Ticket  Ticket  `json:"ticket" gorm:"foreignKey:TicketID"`
+	Version Version `json:"version" gorm:"foreignKey:VersionID"`
+}
+
+// TicketLink is a directional link from the source to the target ticket, the
+// tickets can be in different projects of the same organization
+type TicketLink struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	SourceTicketID uint      `json:"source_ticket_id" gorm:"not null;uniqueIndex:idx_ticket_link"`
+	TargetTicketID uint      `json:"target_ticket_id" gorm:"not null;index;uniqueIndex:idx_ticket_link"`
+	LinkType       string    `json:"link_type" gorm:"not null;size:20;uniqueIndex:idx_ticket_link"` // blocks, relates, duplicates or clones
+	CreatedBy      uint      `json:"created_by" gorm:"not null"`
+	CreatedAt      time.Time `json:"created_at"`
 
-// 	// Relationships
-// 	Project Project  `json:"project" gorm:"foreignKey:ProjectID"`
-// 	Tickets []Ticket `json:"tickets,omitempty" gorm:"many2many:ticket_versions"`
-// }
+	// Relationships
+	Source Ticket `json:"source" gorm:"foreignKey:SourceTicketID"`
+	Target Ticket `json:"target" gorm:"foreignKey:TargetTicketID"`
+}
 
 type Resolution struct {
 	BaseModel
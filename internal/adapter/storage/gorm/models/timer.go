@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Timer is the running timer of a user on a ticket, a user has at most one.
+// Stopping it creates a TimeLog.
+type Timer struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	UserID      uint      `json:"user_id" gorm:"not null;uniqueIndex"`
+	TicketID    uint      `json:"ticket_id" gorm:"not null;index"`
+	Description string    `json:"description" gorm:"type:text"`
+	StartedAt   time.Time `json:"started_at" gorm:"not null"`
+
+	// Relationships
+	Ticket Ticket `json:"ticket" gorm:"foreignKey:TicketID"`
+	User   User   `json:"user" gorm:"foreignKey:UserID"`
+}
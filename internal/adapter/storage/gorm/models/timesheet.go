@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// Timesheet is the week of time logs of a user in an organization, it only
+// exists once the user submitted the week. WeekStart is the Monday of the week.
+type Timesheet struct {
+	BaseModel
+
+	OrganizationID uint       `json:"organization_id" gorm:"not null;uniqueIndex:idx_timesheet_week"`
+	UserID         uint       `json:"user_id" gorm:"not null;uniqueIndex:idx_timesheet_week"`
+	WeekStart      time.Time  `json:"week_start" gorm:"type:date;not null;uniqueIndex:idx_timesheet_week"`
+	Status         string     `json:"status" gorm:"not null;size:20;default:'draft';index"` // draft, submitted, approved or rejected
+	TotalHours     float64    `json:"total_hours" gorm:"not null;default:0"`
+	SubmittedAt    *time.Time `json:"submitted_at"`
+	ReviewedBy     *uint      `json:"reviewed_by"`
+	ReviewedAt     *time.Time `json:"reviewed_at"`
+	ReviewComment  string     `json:"review_comment" gorm:"type:text"`
+
+	// Relationships
+	User User `json:"user" gorm:"foreignKey:UserID"`
+}
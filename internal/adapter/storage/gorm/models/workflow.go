@@ -0,0 +1,22 @@
+package models
+
+type WorkflowTransition struct {
+	BaseModel
+
+	ProjectID    uint   `json:"project_id" gorm:"not null;index"`
+	TypeID       *uint  `json:"type_id" gorm:"index"`        // nil applies to every ticket type of the project
+	FromStatusID *uint  `json:"from_status_id" gorm:"index"` // nil allows the transition from any status
+	ToStatusID   uint   `json:"to_status_id" gorm:"not null;index"`
+	Name         string `json:"name" gorm:"not null;size:100"`
+
+	// Guards
+	RequireResolution  bool `json:"require_resolution" gorm:"not null;default:false"`
+	RequireAssignee    bool `json:"require_assignee" gorm:"not null;default:false"`
+	RequireManageTasks bool `json:"require_manage_tasks" gorm:"not null;default:false"`
+
+	// Relationships
+	Project    Project       `json:"project" gorm:"foreignKey:ProjectID"`
+	Type       *TicketType   `json:"type,omitempty" gorm:"foreignKey:TypeID"`
+	FromStatus *TicketStatus `json:"from_status,omitempty" gorm:"foreignKey:FromStatusID"`
+	ToStatus   TicketStatus  `json:"to_status" gorm:"foreignKey:ToStatusID"`
+}
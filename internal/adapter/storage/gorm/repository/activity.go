@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"task-management/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ActivityRepository struct {
+	db *gorm.DB
+}
+
+func NewActivityRepository(db *gorm.DB) *ActivityRepository {
+	return &ActivityRepository{db: db}
+}
+
+func (r *ActivityRepository) CreateActivities(ctx *fiber.Ctx, activities []*domain.TicketActivity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+
+	activityModels := make([]models.TicketActivity, len(activities))
+	for i, activity := range activities {
+		activityModels[i] = models.TicketActivity{
+			TicketID:  activity.TicketID,
+			ProjectID: activity.ProjectID,
+			UserID:    activity.UserID,
+			Action:    activity.Action,
+			Field:     activity.Field,
+			OldValue:  activity.OldValue,
+			NewValue:  activity.NewValue,
+		}
+	}
+
+	if err := r.db.Create(&activityModels).Error; err != nil {
+		return err
+	}
+
+	for i := range activities {
+		activities[i].ID = activityModels[i].ID
+		activities[i].CreatedAt = activityModels[i].CreatedAt
+	}
+
+	return nil
+}
+
+func (r *ActivityRepository) GetTicketActivities(ctx *fiber.Ctx, projectID uint, ticketID uint) (int64, int64, int64, []*domain.TicketActivity, error) {
+	query := r.db.Where("project_id = ? AND ticket_id = ?", projectID, ticketID)
+	return r.findActivities(ctx, query)
+}
+
+func (r *ActivityRepository) GetProjectActivities(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.TicketActivity, error) {
+	query := r.db.Where("project_id = ?", projectID)
+	return r.findActivities(ctx, query)
+}
+
+func (r *ActivityRepository) findActivities(ctx *fiber.Ctx, query *gorm.DB) (int64, int64, int64, []*domain.TicketActivity, error) {
+	total, page, limit, activities, err := util.FindAll[models.TicketActivity](ctx, query, "User")
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	result := make([]*domain.TicketActivity, len(activities))
+	for i, activity := range activities {
+		result[i] = &domain.TicketActivity{
+			ID:        activity.ID,
+			TicketID:  activity.TicketID,
+			ProjectID: activity.ProjectID,
+			UserID:    activity.UserID,
+			Action:    activity.Action,
+			Field:     activity.Field,
+			OldValue:  activity.OldValue,
+			NewValue:  activity.NewValue,
+			User:      userModelToDomain(&activity.User),
+			CreatedAt: activity.CreatedAt,
+		}
+	}
+
+	return total, page, limit, result, nil
+}
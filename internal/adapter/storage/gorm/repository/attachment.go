@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type AttachmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAttachmentRepository(db *gorm.DB) *AttachmentRepository {
+	return &AttachmentRepository{db: db}
+}
+
+func (r *AttachmentRepository) GetAttachments(ctx *fiber.Ctx, ticketID uint) ([]*domain.Attachment, error) {
+	var attachments []models.TicketAttachment
+	if err := r.db.Preload("Uploader").Preload("Thumbnails").Where("ticket_id = ?", ticketID).Order("id asc").Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		result[i] = r.modelToDomain(&attachment)
+	}
+	return result, nil
+}
+
+func (r *AttachmentRepository) GetAttachmentByID(ctx *fiber.Ctx, ticketID uint, id uint) (*domain.Attachment, error) {
+	var attachment models.TicketAttachment
+	if err := r.db.Preload("Uploader").Preload("Thumbnails").Where("ticket_id = ?", ticketID).First(&attachment, id).Error; err != nil {
+		return nil, err
+	}
+	return r.modelToDomain(&attachment), nil
+}
+
+func (r *AttachmentRepository) GetActiveAttachment(ctx *fiber.Ctx, id uint) (*domain.Attachment, error) {
+	var attachment models.TicketAttachment
+	err := r.db.Preload("Thumbnails").Joins("JOIN tickets ON tickets.id = ticket_attachments.ticket_id AND tickets.deleted_at IS NULL").
+		Joins("JOIN projects ON projects.id = tickets.project_id AND projects.deleted_at IS NULL").
+		First(&attachment, "ticket_attachments.id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelToDomain(&attachment), nil
+}
+
+func (r *AttachmentRepository) CreateAttachment(ctx *fiber.Ctx, attachment *domain.Attachment) error {
+	attachmentModel := attachmentDomainToModel(attachment)
+
+	if err := r.db.Create(&attachmentModel).Error; err != nil {
+		return err
+	}
+
+	attachment.ID = attachmentModel.ID
+	attachment.CreatedAt = attachmentModel.CreatedAt
+
+	return nil
+}
+
+func (r *AttachmentRepository) DeleteAttachment(ctx *fiber.Ctx, ticketID uint, id uint) error {
+	result := r.db.Where("id = ? AND ticket_id = ?", id, ticketID).Delete(&models.TicketAttachment{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// attachmentDomainToModel builds the model of a new attachment, its thumbnails
+// are created with it.
+func attachmentDomainToModel(attachment *domain.Attachment) models.TicketAttachment {
+	model := models.TicketAttachment{
+		TicketID:   attachment.TicketID,
+		Filename:   attachment.Filename,
+		FilePath:   attachment.FilePath,
+		FileSize:   attachment.FileSize,
+		MimeType:   attachment.MimeType,
+		UploadedBy: attachment.UploadedBy,
+		Width:      attachment.Width,
+		Height:     attachment.Height,
+	}
+	for _, thumbnail := range attachment.Thumbnails {
+		model.Thumbnails = append(model.Thumbnails, models.AttachmentThumbnail{
+			Size:     thumbnail.Size,
+			FilePath: thumbnail.FilePath,
+			FileSize: thumbnail.FileSize,
+			MimeType: thumbnail.MimeType,
+			Width:    thumbnail.Width,
+			Height:   thumbnail.Height,
+		})
+	}
+	return model
+}
+
+func (r *AttachmentRepository) modelToDomain(model *models.TicketAttachment) *domain.Attachment {
+	attachment := &domain.Attachment{
+		ID:         model.ID,
+		TicketID:   model.TicketID,
+		Filename:   model.Filename,
+		FilePath:   model.FilePath,
+		FileSize:   model.FileSize,
+		MimeType:   model.MimeType,
+		UploadedBy: model.UploadedBy,
+		Width:      model.Width,
+		Height:     model.Height,
+		CreatedAt:  model.CreatedAt,
+		Thumbnails: make([]*domain.AttachmentThumbnail, len(model.Thumbnails)),
+	}
+	if model.Uploader.ID != 0 {
+		attachment.Uploader = userModelToDomain(&model.Uploader)
+	}
+	for i, thumbnail := range model.Thumbnails {
+		attachment.Thumbnails[i] = &domain.AttachmentThumbnail{
+			Size:     thumbnail.Size,
+			FilePath: thumbnail.FilePath,
+			FileSize: thumbnail.FileSize,
+			MimeType: thumbnail.MimeType,
+			Width:    thumbnail.Width,
+			Height:   thumbnail.Height,
+		}
+	}
+	return attachment
+}
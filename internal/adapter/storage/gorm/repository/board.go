@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"task-management/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type BoardRepository struct {
+	db *gorm.DB
+}
+
+func NewBoardRepository(db *gorm.DB) *BoardRepository {
+	return &BoardRepository{db: db}
+}
+
+func (r *BoardRepository) GetBoards(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Board, error) {
+	query := r.db.Where("project_id = ?", projectID).Scopes(boardColumns)
+
+	total, page, limit, boards, err := util.FindAll[models.Board](ctx, query)
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	result := make([]*domain.Board, len(boards))
+	for i, board := range boards {
+		result[i] = boardModelToDomain(&board)
+	}
+	return total, page, limit, result, nil
+}
+
+func (r *BoardRepository) GetBoardByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Board, error) {
+	var board models.Board
+	if err := r.db.Where("project_id = ?", projectID).Scopes(boardColumns).First(&board, id).Error; err != nil {
+		return nil, err
+	}
+	return boardModelToDomain(&board), nil
+}
+
+func (r *BoardRepository) CreateBoard(ctx *fiber.Ctx, board *domain.Board) error {
+	boardModel := models.Board{
+		ProjectID:  board.ProjectID,
+		Name:       board.Name,
+		SwimlaneBy: board.SwimlaneBy,
+		WIPMode:    board.WIPMode,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&boardModel).Error; err != nil {
+			return err
+		}
+		return createBoardColumns(tx, boardModel.ID, board.Columns)
+	})
+	if err != nil {
+		return err
+	}
+
+	board.ID = boardModel.ID
+	board.CreatedAt = boardModel.CreatedAt
+	board.UpdatedAt = boardModel.UpdatedAt
+
+	return nil
+}
+
+func (r *BoardRepository) UpdateBoard(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateBoardRequest, columns []*domain.BoardColumn) (*domain.Board, error) {
+	updates := map[string]interface{}{}
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.SwimlaneBy != nil {
+		updates["swimlane_by"] = *req.SwimlaneBy
+	}
+	if req.WIPMode != nil {
+		updates["wip_mode"] = *req.WIPMode
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if len(updates) > 0 {
+			result := tx.Model(&models.Board{}).Where("id = ? AND project_id = ?", id, projectID).Updates(updates)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return gorm.ErrRecordNotFound
+			}
+		}
+
+		if columns == nil {
+			return nil
+		}
+
+		var columnIDs []uint
+		if err := tx.Model(&models.BoardColumn{}).Where("board_id = ?", id).Pluck("id", &columnIDs).Error; err != nil {
+			return err
+		}
+		if len(columnIDs) > 0 {
+			if err := tx.Exec("DELETE FROM board_column_statuses WHERE board_column_id IN ?", columnIDs).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("id IN ?", columnIDs).Delete(&models.BoardColumn{}).Error; err != nil {
+				return err
+			}
+		}
+		return createBoardColumns(tx, id, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetBoardByID(ctx, projectID, id)
+}
+
+func (r *BoardRepository) DeleteBoard(ctx *fiber.Ctx, projectID uint, id uint) error {
+	result := r.db.Where("project_id = ?", projectID).Delete(&models.Board{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *BoardRepository) GetBoardTickets(ctx *fiber.Ctx, projectID uint, statusIDs []uint) ([]*domain.Ticket, error) {
+	var tickets []models.Ticket
+	err := r.db.Where("project_id = ? AND status_id IN ?", projectID, statusIDs).
+		Preload("Type").Preload("Priority").Preload("Assignee").
+		Order("rank = '' ASC, rank ASC, id ASC").
+		Find(&tickets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Ticket, len(tickets))
+	for i, ticket := range tickets {
+		result[i] = ticketModelToDomain(&ticket)
+	}
+	return result, nil
+}
+
+func (r *BoardRepository) GetTicketsByIDs(ctx *fiber.Ctx, projectID uint, ids []uint) ([]*domain.Ticket, error) {
+	var tickets []models.Ticket
+	if err := r.db.Where("project_id = ? AND id IN ?", projectID, ids).Preload("Type").Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Ticket, len(tickets))
+	for i, ticket := range tickets {
+		result[i] = ticketModelToDomain(&ticket)
+	}
+	return result, nil
+}
+
+// columnsEntered returns the limited columns that contain toStatusID but not
+// fromStatusID, with the number of tickets in them besides ticketID
+func columnsEntered(tx *gorm.DB, projectID uint, ticketID uint, fromStatusID uint, toStatusID uint) ([]*domain.ColumnWIP, error) {
+	var columns []*domain.ColumnWIP
+	err := tx.Raw(`SELECT boards.id AS board_id, boards.name AS board_name, boards.wip_mode,
+	board_columns.id AS column_id, board_columns.name AS column_name, board_columns.wip_limit,
+	(SELECT COUNT(*) FROM tickets
+		JOIN board_column_statuses counted ON counted.ticket_status_id = tickets.status_id
+		WHERE counted.board_column_id = board_columns.id AND tickets.project_id = boards.project_id
+		AND tickets.deleted_at IS NULL AND tickets.id <> ?) AS ticket_count
+FROM board_columns
+JOIN boards ON boards.id = board_columns.board_id AND boards.deleted_at IS NULL
+JOIN board_column_statuses ON board_column_statuses.board_column_id = board_columns.id
+WHERE boards.project_id = ? AND board_column_statuses.ticket_status_id = ? AND board_columns.wip_limit IS NOT NULL
+AND NOT EXISTS (SELECT 1 FROM board_column_statuses source
+	WHERE source.board_column_id = board_columns.id AND source.ticket_status_id = ?)
+ORDER BY boards.id, board_columns.position`, ticketID, projectID, toStatusID, fromStatusID).Scan(&columns).Error
+	if err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+// boardColumns preloads the columns in display order with their statuses
+func boardColumns(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Columns", func(db *gorm.DB) *gorm.DB { return db.Order("position ASC") }).
+		Preload("Columns.Statuses", func(db *gorm.DB) *gorm.DB { return db.Order("position ASC") })
+}
+
+func createBoardColumns(tx *gorm.DB, boardID uint, columns []*domain.BoardColumn) error {
+	for i, column := range columns {
+		columnModel := models.BoardColumn{
+			BoardID:  boardID,
+			Name:     column.Name,
+			Position: i,
+			WIPLimit: column.WIPLimit,
+		}
+		if err := tx.Create(&columnModel).Error; err != nil {
+			return err
+		}
+
+		for _, statusID := range column.StatusIDs {
+			err := tx.Exec("INSERT INTO board_column_statuses (board_column_id, ticket_status_id) VALUES (?, ?) ON CONFLICT DO NOTHING", columnModel.ID, statusID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		column.ID = columnModel.ID
+		column.Position = i
+	}
+	return nil
+}
+
+func boardModelToDomain(model *models.Board) *domain.Board {
+	board := &domain.Board{
+		ID:         model.ID,
+		ProjectID:  model.ProjectID,
+		Name:       model.Name,
+		SwimlaneBy: model.SwimlaneBy,
+		WIPMode:    model.WIPMode,
+		Columns:    make([]*domain.BoardColumn, len(model.Columns)),
+		CreatedAt:  model.CreatedAt,
+		UpdatedAt:  model.UpdatedAt,
+	}
+
+	for i, columnModel := range model.Columns {
+		column := &domain.BoardColumn{
+			ID:        columnModel.ID,
+			Name:      columnModel.Name,
+			Position:  columnModel.Position,
+			WIPLimit:  columnModel.WIPLimit,
+			StatusIDs: []uint{},
+		}
+		for _, status := range columnModel.Statuses {
+			column.StatusIDs = append(column.StatusIDs, status.ID)
+			column.Statuses = append(column.Statuses, ticketStatusModelToDomain(&status))
+		}
+		board.Columns[i] = column
+	}
+
+	return board
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"task-management/internal/util"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type CommentRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentRepository(db *gorm.DB) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+// GetComments includes deleted comments so listings can show them as tombstones.
+func (r *CommentRepository) GetComments(ctx *fiber.Ctx, ticketID uint) (int64, int64, int64, []*domain.TicketComment, error) {
+	query := r.db.Unscoped().Where("ticket_id = ?", ticketID)
+
+	total, page, limit, comments, err := util.FindAll[models.TicketComment](ctx, query, "User")
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	result := make([]*domain.TicketComment, len(comments))
+	for i, comment := range comments {
+		result[i] = r.modelToDomain(&comment)
+	}
+
+	return total, page, limit, result, nil
+}
+
+func (r *CommentRepository) GetCommentByID(ctx *fiber.Ctx, ticketID uint, id uint) (*domain.TicketComment, error) {
+	var comment models.TicketComment
+	if err := r.db.Preload("User").Where("ticket_id = ?", ticketID).First(&comment, id).Error; err != nil {
+		return nil, err
+	}
+	return r.modelToDomain(&comment), nil
+}
+
+func (r *CommentRepository) CreateComment(ctx *fiber.Ctx, comment *domain.TicketComment) error {
+	commentModel := models.TicketComment{
+		TicketID: comment.TicketID,
+		UserID:   comment.UserID,
+		Content:  comment.Content,
+	}
+
+	if err := r.db.Create(&commentModel).Error; err != nil {
+		return err
+	}
+
+	comment.ID = commentModel.ID
+	comment.CreatedAt = commentModel.CreatedAt
+	comment.UpdatedAt = commentModel.UpdatedAt
+
+	return nil
+}
+
+// UpdateComment stores the current content as a revision before replacing it.
+func (r *CommentRepository) UpdateComment(ctx *fiber.Ctx, comment *domain.TicketComment, content string, editedBy uint) (*domain.TicketComment, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		revision := models.TicketCommentRevision{
+			CommentID: comment.ID,
+			Content:   comment.Content,
+			EditedBy:  editedBy,
+		}
+		if err := tx.Create(&revision).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.TicketComment{}).Where("id = ?", comment.ID).
+			Updates(map[string]interface{}{"content": content, "edited_at": time.Now()}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetCommentByID(ctx, comment.TicketID, comment.ID)
+}
+
+func (r *CommentRepository) DeleteComment(ctx *fiber.Ctx, ticketID uint, id uint, deletedBy uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.TicketComment{}).Where("id = ? AND ticket_id = ?", id, ticketID).Update("deleted_by", deletedBy)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Where("id = ?", id).Delete(&models.TicketComment{}).Error
+	})
+}
+
+func (r *CommentRepository) GetCommentRevisions(ctx *fiber.Ctx, commentID uint) ([]*domain.TicketCommentRevision, error) {
+	var revisions []models.TicketCommentRevision
+	if err := r.db.Preload("User").Where("comment_id = ?", commentID).Order("id asc").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.TicketCommentRevision, len(revisions))
+	for i, revision := range revisions {
+		result[i] = &domain.TicketCommentRevision{
+			ID:        revision.ID,
+			CommentID: revision.CommentID,
+			Content:   revision.Content,
+			EditedBy:  revision.EditedBy,
+			User:      userModelToDomain(&revision.User),
+			CreatedAt: revision.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+func (r *CommentRepository) modelToDomain(model *models.TicketComment) *domain.TicketComment {
+	comment := &domain.TicketComment{
+		ID:        model.ID,
+		TicketID:  model.TicketID,
+		UserID:    model.UserID,
+		Content:   model.Content,
+		IsEdited:  model.EditedAt != nil,
+		EditedAt:  model.EditedAt,
+		DeletedBy: model.DeletedBy,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+	if model.User.ID != 0 {
+		comment.User = userModelToDomain(&model.User)
+	}
+
+	// Deleted comments keep their place in the thread without their content
+	if model.DeletedAt.Valid {
+		comment.IsDeleted = true
+		comment.DeletedAt = &model.DeletedAt.Time
+		comment.Content = ""
+	}
+
+	return comment
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"task-management/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ComponentRepository struct {
+	db *gorm.DB
+}
+
+func NewComponentRepository(db *gorm.DB) *ComponentRepository {
+	return &ComponentRepository{db: db}
+}
+
+func (r *ComponentRepository) GetComponents(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Component, error) {
+	query := r.db.Where("project_id = ?", projectID)
+
+	total, page, limit, components, err := util.FindAll[models.Component](ctx, query, "Lead")
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	result := make([]*domain.Component, len(components))
+	for i, component := range components {
+		result[i] = componentModelToDomain(&component)
+	}
+	return total, page, limit, result, nil
+}
+
+func (r *ComponentRepository) GetComponentByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Component, error) {
+	var component models.Component
+	if err := r.db.Preload("Lead").Where("project_id = ?", projectID).First(&component, id).Error; err != nil {
+		return nil, err
+	}
+	return componentModelToDomain(&component), nil
+}
+
+func (r *ComponentRepository) GetComponentsByIDs(ctx *fiber.Ctx, projectID uint, ids []uint) ([]*domain.Component, error) {
+	var components []models.Component
+	if err := r.db.Where("project_id = ? AND id IN ?", projectID, ids).Find(&components).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Component, len(components))
+	for i, component := range components {
+		result[i] = componentModelToDomain(&component)
+	}
+	return result, nil
+}
+
+func (r *ComponentRepository) CreateComponent(ctx *fiber.Ctx, component *domain.Component) error {
+	componentModel := models.Component{
+		ProjectID:   component.ProjectID,
+		Name:        component.Name,
+		Description: component.Description,
+		LeadID:      component.LeadID,
+		IsActive:    true,
+	}
+
+	if err := r.db.Create(&componentModel).Error; err != nil {
+		return err
+	}
+
+	component.ID = componentModel.ID
+	component.IsActive = componentModel.IsActive
+	component.CreatedAt = componentModel.CreatedAt
+	component.UpdatedAt = componentModel.UpdatedAt
+
+	return nil
+}
+
+func (r *ComponentRepository) UpdateComponent(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateComponentRequest) (*domain.Component, error) {
+	updates := map[string]interface{}{}
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.LeadID != nil {
+		updates["lead_id"] = nullableID(*req.LeadID)
+	}
+	if req.IsActive != nil {
+		updates["is_active"] = *req.IsActive
+	}
+
+	if len(updates) > 0 {
+		result := r.db.Model(&models.Component{}).Where("id = ? AND project_id = ?", id, projectID).Updates(updates)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil, gorm.ErrRecordNotFound
+		}
+	}
+
+	return r.GetComponentByID(ctx, projectID, id)
+}
+
+// DeleteComponent removes the component from every ticket and deletes it
+func (r *ComponentRepository) DeleteComponent(ctx *fiber.Ctx, projectID uint, id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND project_id = ?", id, projectID).Delete(&models.Component{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Exec("DELETE FROM ticket_components WHERE component_id = ?", id).Error
+	})
+}
+
+func (r *ComponentRepository) AddTicketComponent(ctx *fiber.Ctx, ticketID uint, componentID uint) (bool, error) {
+	result := r.db.Exec("INSERT INTO ticket_components (ticket_id, component_id) VALUES (?, ?) ON CONFLICT DO NOTHING", ticketID, componentID)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *ComponentRepository) RemoveTicketComponent(ctx *fiber.Ctx, ticketID uint, componentID uint) error {
+	result := r.db.Exec("DELETE FROM ticket_components WHERE ticket_id = ? AND component_id = ?", ticketID, componentID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func componentModelToDomain(model *models.Component) *domain.Component {
+	component := &domain.Component{
+		ID:          model.ID,
+		ProjectID:   model.ProjectID,
+		Name:        model.Name,
+		Description: model.Description,
+		LeadID:      model.LeadID,
+		IsActive:    model.IsActive,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+	if model.Lead != nil {
+		component.Lead = userModelToDomain(model.Lead)
+	}
+	return component
+}
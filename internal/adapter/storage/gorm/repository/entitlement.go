@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type EntitlementRepository struct {
+	db *gorm.DB
+}
+
+func NewEntitlementRepository(db *gorm.DB) *EntitlementRepository {
+	return &EntitlementRepository{db: db}
+}
+
+func (r *EntitlementRepository) GetPlanType(ctx *fiber.Ctx, organizationID uint) (string, error) {
+	var organization models.Organization
+	if err := r.db.Select("id", "plan_type").First(&organization, organizationID).Error; err != nil {
+		return "", err
+	}
+	return organization.PlanType, nil
+}
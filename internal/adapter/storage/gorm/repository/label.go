@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"errors"
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"task-management/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LabelRepository struct {
+	db *gorm.DB
+}
+
+func NewLabelRepository(db *gorm.DB) *LabelRepository {
+	return &LabelRepository{db: db}
+}
+
+func (r *LabelRepository) GetLabels(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Label, error) {
+	query := r.db.Where("project_id = ?", projectID)
+
+	total, page, limit, labels, err := util.FindAll[models.Label](ctx, query)
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	result := make([]*domain.Label, len(labels))
+	for i, label := range labels {
+		result[i] = labelModelToDomain(&label)
+	}
+	return total, page, limit, result, nil
+}
+
+func (r *LabelRepository) GetLabelByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Label, error) {
+	var label models.Label
+	if err := r.db.Where("project_id = ?", projectID).First(&label, id).Error; err != nil {
+		return nil, err
+	}
+	return labelModelToDomain(&label), nil
+}
+
+func (r *LabelRepository) FindLabelByName(ctx *fiber.Ctx, projectID uint, name string) (*domain.Label, error) {
+	var labels []models.Label
+	if err := r.db.Where("project_id = ? AND LOWER(name) = LOWER(?)", projectID, name).Limit(1).Find(&labels).Error; err != nil {
+		return nil, err
+	}
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	return labelModelToDomain(&labels[0]), nil
+}
+
+func (r *LabelRepository) CreateLabel(ctx *fiber.Ctx, label *domain.Label) error {
+	labelModel := models.Label{
+		ProjectID:   label.ProjectID,
+		Name:        label.Name,
+		Color:       label.Color,
+		Description: label.Description,
+	}
+
+	// The unique index on (project_id, LOWER(name)) catches concurrent creates
+	if err := r.db.Create(&labelModel).Error; err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrLabelExists
+		}
+		return err
+	}
+
+	label.ID = labelModel.ID
+	label.CreatedAt = labelModel.CreatedAt
+	label.UpdatedAt = labelModel.UpdatedAt
+
+	return nil
+}
+
+func (r *LabelRepository) UpdateLabel(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateLabelRequest) (*domain.Label, error) {
+	updates := map[string]interface{}{}
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.Color != nil {
+		updates["color"] = *req.Color
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+
+	if len(updates) > 0 {
+		result := r.db.Model(&models.Label{}).Where("id = ? AND project_id = ?", id, projectID).Updates(updates)
+		if isUniqueViolation(result.Error) {
+			return nil, domain.ErrLabelExists
+		}
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil, gorm.ErrRecordNotFound
+		}
+	}
+
+	return r.GetLabelByID(ctx, projectID, id)
+}
+
+// DeleteLabel removes the label from every ticket and deletes it
+func (r *LabelRepository) DeleteLabel(ctx *fiber.Ctx, projectID uint, id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND project_id = ?", id, projectID).Delete(&models.Label{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Exec("DELETE FROM ticket_labels WHERE label_id = ?", id).Error
+	})
+}
+
+func (r *LabelRepository) MergeLabels(ctx *fiber.Ctx, projectID uint, sourceID uint, targetID uint) (int64, error) {
+	var moved int64
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Lock both labels in id order, so merges of the same labels in either
+		// direction wait for each other and the second one sees the deletion
+		var labels []models.Label
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND project_id = ?", []uint{sourceID, targetID}, projectID).
+			Order("id").
+			Find(&labels).Error
+		if err != nil {
+			return err
+		}
+
+		found := map[uint]bool{}
+		for _, label := range labels {
+			found[label.ID] = true
+		}
+		if !found[sourceID] {
+			return gorm.ErrRecordNotFound
+		}
+		if !found[targetID] {
+			return domain.ErrLabelMergeTarget
+		}
+
+		if err := tx.Delete(&models.Label{}, sourceID).Error; err != nil {
+			return err
+		}
+
+		// Tickets that already have both labels keep a single row
+		result := tx.Exec(`INSERT INTO ticket_labels (ticket_id, label_id)
+SELECT ticket_id, ? FROM ticket_labels WHERE label_id = ?
+ON CONFLICT DO NOTHING`, targetID, sourceID)
+		if result.Error != nil {
+			return result.Error
+		}
+		moved = result.RowsAffected
+
+		return tx.Exec("DELETE FROM ticket_labels WHERE label_id = ?", sourceID).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return moved, nil
+}
+
+func (r *LabelRepository) AddTicketLabel(ctx *fiber.Ctx, ticketID uint, labelID uint) (bool, error) {
+	result := r.db.Exec("INSERT INTO ticket_labels (ticket_id, label_id) VALUES (?, ?) ON CONFLICT DO NOTHING", ticketID, labelID)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *LabelRepository) RemoveTicketLabel(ctx *fiber.Ctx, ticketID uint, labelID uint) error {
+	result := r.db.Exec("DELETE FROM ticket_labels WHERE ticket_id = ? AND label_id = ?", ticketID, labelID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func labelModelToDomain(model *models.Label) *domain.Label {
+	return &domain.Label{
+		ID:          model.ID,
+		ProjectID:   model.ProjectID,
+		Name:        model.Name,
+		Color:       model.Color,
+		Description: model.Description,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+}
+
+// isUniqueViolation reports whether err is a unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type TicketLinkRepository struct {
+	db *gorm.DB
+}
+
+func NewTicketLinkRepository(db *gorm.DB) *TicketLinkRepository {
+	return &TicketLinkRepository{db: db}
+}
+
+func (r *TicketLinkRepository) GetTicketLinks(ctx *fiber.Ctx, ticketID uint) ([]*domain.TicketLink, error) {
+	var links []models.TicketLink
+	err := r.db.Where("source_ticket_id = ? OR target_ticket_id = ?", ticketID, ticketID).
+		Preload("Source.Status").Preload("Target.Status").
+		Order("link_type ASC, id ASC").
+		Find(&links).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.TicketLink, 0, len(links))
+	for _, link := range links {
+		// Links to deleted tickets are not shown
+		if link.Source.ID == 0 || link.Target.ID == 0 {
+			continue
+		}
+		result = append(result, ticketLinkModelToDomain(&link, ticketID))
+	}
+	return result, nil
+}
+
+func (r *TicketLinkRepository) GetTicketLinkByID(ctx *fiber.Ctx, ticketID uint, id uint) (*domain.TicketLink, error) {
+	var link models.TicketLink
+	err := r.db.Where("source_ticket_id = ? OR target_ticket_id = ?", ticketID, ticketID).
+		Preload("Source").Preload("Target").
+		First(&link, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return ticketLinkModelToDomain(&link, ticketID), nil
+}
+
+func (r *TicketLinkRepository) CreateTicketLink(ctx *fiber.Ctx, link *domain.TicketLink) error {
+	linkModel := models.TicketLink{
+		SourceTicketID: link.SourceTicketID,
+		TargetTicketID: link.TargetTicketID,
+		LinkType:       link.LinkType,
+		CreatedBy:      link.CreatedBy,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if link.LinkType == domain.LinkTypeBlocks {
+			// Blocks links are added one at a time so that two links cannot
+			// close a cycle together
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext('ticket_links_blocks'))").Error; err != nil {
+				return err
+			}
+
+			var cycle bool
+			err := tx.Raw(`WITH RECURSIVE blocked(id) AS (
+	SELECT CAST(? AS bigint)
+	UNION
+	SELECT ticket_links.target_ticket_id FROM ticket_links
+	JOIN blocked ON ticket_links.source_ticket_id = blocked.id
+	WHERE ticket_links.link_type = ?
+)
+SELECT EXISTS (SELECT 1 FROM blocked WHERE id = ?)`, link.TargetTicketID, domain.LinkTypeBlocks, link.SourceTicketID).Scan(&cycle).Error
+			if err != nil {
+				return err
+			}
+			if cycle {
+				return domain.ErrLinkCycle
+			}
+		}
+
+		var existing int64
+		err := tx.Model(&models.TicketLink{}).
+			Where("link_type = ?", link.LinkType).
+			Where("(source_ticket_id = ? AND target_ticket_id = ?) OR (source_ticket_id = ? AND target_ticket_id = ?)",
+				link.SourceTicketID, link.TargetTicketID, link.TargetTicketID, link.SourceTicketID).
+			Count(&existing).Error
+		if err != nil {
+			return err
+		}
+		if existing > 0 {
+			return domain.ErrLinkExists
+		}
+
+		return tx.Create(&linkModel).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	link.ID = linkModel.ID
+	link.CreatedAt = linkModel.CreatedAt
+
+	return nil
+}
+
+func (r *TicketLinkRepository) DeleteTicketLink(ctx *fiber.Ctx, id uint) error {
+	result := r.db.Delete(&models.TicketLink{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *TicketLinkRepository) GetOpenBlockedTickets(ctx *fiber.Ctx, ticketID uint) ([]*domain.Ticket, error) {
+	var tickets []models.Ticket
+	err := r.db.
+		Joins("JOIN ticket_links ON ticket_links.target_ticket_id = tickets.id").
+		Joins("JOIN ticket_statuses ON ticket_statuses.id = tickets.status_id").
+		Where("ticket_links.source_ticket_id = ? AND ticket_links.link_type = ?", ticketID, domain.LinkTypeBlocks).
+		Where("ticket_statuses.is_closed = ?", false).
+		Order("tickets.id ASC").
+		Find(&tickets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Ticket, len(tickets))
+	for i, ticket := range tickets {
+		result[i] = ticketModelToDomain(&ticket)
+	}
+	return result, nil
+}
+
+// ticketLinkModelToDomain maps the link seen from ticketID, the other ticket
+// is only mapped when it was preloaded
+func ticketLinkModelToDomain(model *models.TicketLink, ticketID uint) *domain.TicketLink {
+	direction, other := domain.LinkDirectionOutward, &model.Target
+	if model.SourceTicketID != ticketID {
+		direction, other = domain.LinkDirectionInward, &model.Source
+	}
+
+	link := &domain.TicketLink{
+		ID:             model.ID,
+		SourceTicketID: model.SourceTicketID,
+		TargetTicketID: model.TargetTicketID,
+		LinkType:       model.LinkType,
+		Direction:      direction,
+		Description:    domain.LinkDescription(model.LinkType, direction),
+		CreatedBy:      model.CreatedBy,
+		CreatedAt:      model.CreatedAt,
+	}
+	if other.ID != 0 {
+		link.Ticket = ticketModelToDomain(other)
+	}
+	return link
+}
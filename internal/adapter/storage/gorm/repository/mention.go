@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type MentionRepository struct {
+	db *gorm.DB
+}
+
+func NewMentionRepository(db *gorm.DB) *MentionRepository {
+	return &MentionRepository{db: db}
+}
+
+func (r *MentionRepository) FindOrganizationUsers(ctx *fiber.Ctx, handles []string) ([]*domain.User, error) {
+	var users []models.User
+	err := r.db.Joins("JOIN organization_members ON organization_members.user_id = users.id AND organization_members.deleted_at IS NULL").
+		Where("organization_members.organization_id = ?", ctx.Locals("organization_id")).
+		Where("LOWER(users.email) IN ? OR LOWER(users.display_name) IN ?", handles, handles).
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.User, len(users))
+	for i, user := range users {
+		result[i] = userModelToDomain(&user)
+	}
+	return result, nil
+}
+
+func (r *MentionRepository) CreateMentions(ctx *fiber.Ctx, mentions []*domain.TicketMention) ([]*domain.TicketMention, error) {
+	created := []*domain.TicketMention{}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, mention := range mentions {
+			query := tx.Model(&models.TicketMention{}).Where("ticket_id = ? AND user_id = ?", mention.TicketID, mention.UserID)
+			if mention.CommentID == nil {
+				query = query.Where("comment_id IS NULL")
+			} else {
+				query = query.Where("comment_id = ?", *mention.CommentID)
+			}
+
+			var count int64
+			if err := query.Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				continue
+			}
+
+			mentionModel := models.TicketMention{
+				TicketID:    mention.TicketID,
+				CommentID:   mention.CommentID,
+				UserID:      mention.UserID,
+				MentionedBy: mention.MentionedBy,
+			}
+			if err := tx.Create(&mentionModel).Error; err != nil {
+				return err
+			}
+
+			mention.ID = mentionModel.ID
+			mention.CreatedAt = mentionModel.CreatedAt
+			created = append(created, mention)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *MentionRepository) GetMentions(ctx *fiber.Ctx, ticketID uint) ([]*domain.TicketMention, error) {
+	var mentions []models.TicketMention
+	if err := r.db.Preload("User").Where("ticket_id = ?", ticketID).Order("id asc").Find(&mentions).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.TicketMention, len(mentions))
+	for i, mention := range mentions {
+		result[i] = &domain.TicketMention{
+			ID:          mention.ID,
+			TicketID:    mention.TicketID,
+			CommentID:   mention.CommentID,
+			UserID:      mention.UserID,
+			MentionedBy: mention.MentionedBy,
+			User:        userModelToDomain(&mention.User),
+			CreatedAt:   mention.CreatedAt,
+		}
+	}
+	return result, nil
+}
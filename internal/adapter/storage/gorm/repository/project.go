@@ -0,0 +1,340 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"task-management/internal/util"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProjectRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectRepository(db *gorm.DB) *ProjectRepository {
+	return &ProjectRepository{db: db}
+}
+
+func (r *ProjectRepository) GetProjects(ctx *fiber.Ctx, onlyMember bool) (int64, int64, int64, []*domain.Project, error) {
+	query := r.db.Where("organization_id = ?", ctx.Locals("organization_id"))
+
+	if onlyMember {
+		memberOf := r.db.Model(&models.ProjectMember{}).Select("project_id").Where("user_id = ?", ctx.Locals("user_id"))
+		query = query.Where("id IN (?)", memberOf)
+	}
+
+	total, page, limit, projects, err := util.FindAll[models.Project](ctx, query)
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	return total, page, limit, r.modelsToDomain(projects), nil
+}
+
+func (r *ProjectRepository) GetProjectByID(ctx *fiber.Ctx, id uint) (*domain.Project, error) {
+	query := r.db.Where("organization_id = ?", ctx.Locals("organization_id"))
+
+	project, err := util.FindOne[models.Project](ctx, query, int64(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.modelToDomain(project), nil
+}
+
+func (r *ProjectRepository) CreateProject(ctx *fiber.Ctx, project *domain.Project, maxProjects *int64) error {
+	orgID := ctx.Locals("organization_id").(uint)
+
+	projectModel := models.Project{
+		OrganizationID: &orgID,
+		Name:           project.Name,
+		Description:    project.Description,
+		Key:            project.Key,
+		OwnerID:        project.OwnerID,
+		StatusID:       project.StatusID,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkProjectLimit(tx, orgID, maxProjects); err != nil {
+			return err
+		}
+
+		if err := tx.Create(&projectModel).Error; err != nil {
+			return err
+		}
+
+		// The owner always starts as the project manager
+		now := time.Now()
+		ownerMember := models.ProjectMember{
+			ProjectID: projectModel.ID,
+			UserID:    project.OwnerID,
+			RoleID:    domain.ProjectRoleManager,
+			StatusID:  1,
+			JoinedAt:  &now,
+		}
+
+		if err := tx.Create(&ownerMember).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(defaultTicketStatuses(projectModel.ID)).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	project.ID = projectModel.ID
+	project.OrganizationID = orgID
+	project.CreatedAt = projectModel.CreatedAt
+	project.UpdatedAt = projectModel.UpdatedAt
+
+	return nil
+}
+
+func (r *ProjectRepository) UpdateProject(ctx *fiber.Ctx, id uint, project *domain.UpdateProjectRequest, maxProjects *int64) (*domain.Project, error) {
+	if _, err := r.GetProjectByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	projectModel := models.Project{
+		Name:           project.Name,
+		Description:    project.Description,
+		OwnerID:        project.OwnerID,
+		StatusID:       project.StatusID,
+		AutoAssignMode: project.AutoAssignMode,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Unarchiving a project counts toward the project limit again
+		if project.StatusID != 0 && project.StatusID != domain.ProjectStatusArchived {
+			var current models.Project
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "status_id").First(&current, id).Error; err != nil {
+				return err
+			}
+			if current.StatusID == domain.ProjectStatusArchived {
+				if err := checkProjectLimit(tx, ctx.Locals("organization_id").(uint), maxProjects); err != nil {
+					return err
+				}
+			}
+		}
+
+		_, err := util.UpdateOne[models.Project](ctx, tx, int64(id), projectModel)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetProjectByID(ctx, id)
+}
+
+func (r *ProjectRepository) DeleteProject(ctx *fiber.Ctx, id uint) error {
+	if _, err := r.GetProjectByID(ctx, id); err != nil {
+		return err
+	}
+
+	return r.db.Delete(&models.Project{}, id).Error
+}
+
+func (r *ProjectRepository) GetProjectMembers(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.ProjectMember, error) {
+	query := r.db.Where("project_id = ?", projectID)
+
+	total, page, limit, members, err := util.FindAll[models.ProjectMember](ctx, query, "User")
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	result := make([]*domain.ProjectMember, len(members))
+	for i, member := range members {
+		result[i] = r.memberModelToDomain(&member)
+	}
+
+	return total, page, limit, result, nil
+}
+
+func (r *ProjectRepository) AddProjectMember(ctx *fiber.Ctx, member *domain.ProjectMember, maxMembers *int64) error {
+	memberModel := models.ProjectMember{
+		ProjectID: member.ProjectID,
+		UserID:    member.UserID,
+		RoleID:    member.RoleID,
+		StatusID:  member.StatusID,
+		InvitedAt: member.InvitedAt,
+		JoinedAt:  member.JoinedAt,
+		InvitedBy: member.InvitedBy,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the project row so members are counted and added one at a time
+		var project models.Project
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&project, member.ProjectID).Error; err != nil {
+			return err
+		}
+
+		// (project_id, user_id) is unique even for removed members, so a removed
+		// member is restored instead of inserted again
+		var existing models.ProjectMember
+		err := tx.Unscoped().Where("project_id = ? AND user_id = ?", member.ProjectID, member.UserID).First(&existing).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return err
+		}
+		found := err == nil
+
+		// Changing the role of a current member does not add anyone
+		if maxMembers != nil && (!found || existing.DeletedAt.Valid) {
+			var count int64
+			if err := tx.Model(&models.ProjectMember{}).Where("project_id = ?", member.ProjectID).Count(&count).Error; err != nil {
+				return err
+			}
+			if count >= *maxMembers {
+				return domain.ErrPlanLimitReached
+			}
+		}
+
+		if found {
+			memberModel.ID = existing.ID
+			memberModel.CreatedAt = existing.CreatedAt
+			return tx.Unscoped().Save(&memberModel).Error
+		}
+		return tx.Create(&memberModel).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	member.ID = memberModel.ID
+	member.CreatedAt = memberModel.CreatedAt
+	member.UpdatedAt = memberModel.UpdatedAt
+
+	return nil
+}
+
+func (r *ProjectRepository) RemoveProjectMember(ctx *fiber.Ctx, projectID uint, userID uint) error {
+	result := r.db.Where("project_id = ? AND user_id = ?", projectID, userID).Delete(&models.ProjectMember{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *ProjectRepository) GetUserRoleInProjectByID(ctx *fiber.Ctx, projectID uint, userID uint) (*domain.ProjectMemberRole, error) {
+	var role models.VWProjectMemberRole
+
+	err := r.db.Where("project_id = ? AND user_id = ?", projectID, userID).First(&role).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ProjectMemberRole{
+		ID:                  role.ID,
+		Name:                role.Name,
+		Description:         role.Description,
+		IsDefault:           role.IsDefault,
+		CanManageProject:    role.CanManageProject,
+		CanManageMembers:    role.CanManageMembers,
+		CanCreateTasks:      role.CanCreateTasks,
+		CanManageTasks:      role.CanManageTasks,
+		CanDeleteTasks:      role.CanDeleteTasks,
+		CanViewAllTasks:     role.CanViewAllTasks,
+		CanManageComponents: role.CanManageComponents,
+		CanManageVersions:   role.CanManageVersions,
+	}, nil
+}
+
+func (r *ProjectRepository) IsOrganizationMember(ctx *fiber.Ctx, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.OrganizationMember{}).
+		Where("organization_id = ? AND user_id = ?", ctx.Locals("organization_id"), userID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// defaultTicketStatuses is the workflow every new project starts with.
+func defaultTicketStatuses(projectID uint) []*models.TicketStatus {
+	return []*models.TicketStatus{
+		{ProjectID: projectID, Name: "To Do", Color: "#42526E", Position: 0, IsDefault: true, IsActive: true},
+		{ProjectID: projectID, Name: "In Progress", Color: "#0052CC", Position: 1, IsActive: true},
+		{ProjectID: projectID, Name: "In Review", Color: "#FF991F", Position: 2, IsActive: true},
+		{ProjectID: projectID, Name: "Done", Color: "#36B37E", Position: 3, IsActive: true, IsClosed: true, IsResolved: true},
+	}
+}
+
+func (r *ProjectRepository) modelToDomain(model *models.Project) *domain.Project {
+	project := &domain.Project{
+		ID:             model.ID,
+		Name:           model.Name,
+		Description:    model.Description,
+		Key:            model.Key,
+		OwnerID:        model.OwnerID,
+		StatusID:       model.StatusID,
+		AutoAssignMode: model.AutoAssignMode,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}
+	if model.OrganizationID != nil {
+		project.OrganizationID = *model.OrganizationID
+	}
+	return project
+}
+
+func (r *ProjectRepository) modelsToDomain(models []models.Project) []*domain.Project {
+	projects := make([]*domain.Project, len(models))
+	for i, model := range models {
+		projects[i] = r.modelToDomain(&model)
+	}
+	return projects
+}
+
+func (r *ProjectRepository) memberModelToDomain(model *models.ProjectMember) *domain.ProjectMember {
+	member := &domain.ProjectMember{
+		ID:        model.ID,
+		ProjectID: model.ProjectID,
+		UserID:    model.UserID,
+		RoleID:    model.RoleID,
+		StatusID:  model.StatusID,
+		InvitedAt: model.InvitedAt,
+		JoinedAt:  model.JoinedAt,
+		InvitedBy: model.InvitedBy,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+	if model.User != nil {
+		member.User = userModelToDomain(model.User)
+	}
+	return member
+}
+
+// checkProjectLimit locks the organization row so projects are counted and
+// activated one at a time, then fails with ErrPlanLimitReached when the
+// organization already has maxProjects projects that are not archived.
+func checkProjectLimit(tx *gorm.DB, organizationID uint, maxProjects *int64) error {
+	if maxProjects == nil {
+		return nil
+	}
+
+	var organization models.Organization
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&organization, organizationID).Error; err != nil {
+		return err
+	}
+
+	var count int64
+	err := tx.Model(&models.Project{}).
+		Where("organization_id = ? AND status_id <> ?", organizationID, domain.ProjectStatusArchived).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count >= *maxProjects {
+		return domain.ErrPlanLimitReached
+	}
+	return nil
+}
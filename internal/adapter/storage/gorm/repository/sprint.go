@@ -0,0 +1,312 @@
+package repository
+
+import (
+	"fmt"
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"task-management/internal/util"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SprintRepository struct {
+	db *gorm.DB
+}
+
+func NewSprintRepository(db *gorm.DB) *SprintRepository {
+	return &SprintRepository{db: db}
+}
+
+func (r *SprintRepository) GetSprints(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Sprint, error) {
+	query := r.db.Where("project_id = ?", projectID)
+
+	total, page, limit, sprints, err := util.FindAll[models.Sprint](ctx, query)
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	result := make([]*domain.Sprint, len(sprints))
+	for i, sprint := range sprints {
+		result[i] = sprintModelToDomain(&sprint)
+	}
+	return total, page, limit, result, nil
+}
+
+func (r *SprintRepository) GetSprintByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Sprint, error) {
+	var sprint models.Sprint
+	if err := r.db.Where("project_id = ?", projectID).First(&sprint, id).Error; err != nil {
+		return nil, err
+	}
+	return sprintModelToDomain(&sprint), nil
+}
+
+func (r *SprintRepository) CreateSprint(ctx *fiber.Ctx, sprint *domain.Sprint) error {
+	sprintModel := models.Sprint{
+		ProjectID: sprint.ProjectID,
+		Name:      sprint.Name,
+		Goal:      sprint.Goal,
+		State:     domain.SprintStatePlanned,
+		StartDate: sprint.StartDate,
+		EndDate:   sprint.EndDate,
+	}
+
+	if err := r.db.Create(&sprintModel).Error; err != nil {
+		return err
+	}
+
+	sprint.ID = sprintModel.ID
+	sprint.State = sprintModel.State
+	sprint.CreatedAt = sprintModel.CreatedAt
+	sprint.UpdatedAt = sprintModel.UpdatedAt
+
+	return nil
+}
+
+func (r *SprintRepository) UpdateSprint(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateSprintRequest) (*domain.Sprint, error) {
+	updates := map[string]interface{}{}
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.Goal != nil {
+		updates["goal"] = *req.Goal
+	}
+	if req.StartDate != nil {
+		updates["start_date"] = *req.StartDate
+	}
+	if req.EndDate != nil {
+		updates["end_date"] = *req.EndDate
+	}
+
+	if len(updates) > 0 {
+		result := r.db.Model(&models.Sprint{}).Where("id = ? AND project_id = ?", id, projectID).Updates(updates)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil, gorm.ErrRecordNotFound
+		}
+	}
+
+	return r.GetSprintByID(ctx, projectID, id)
+}
+
+func (r *SprintRepository) DeleteSprint(ctx *fiber.Ctx, projectID uint, id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		sprint, err := r.lockSprint(tx, projectID, id)
+		if err != nil {
+			return err
+		}
+		if sprint.State != domain.SprintStatePlanned {
+			return domain.ErrSprintNotPlanned
+		}
+
+		if err := tx.Model(&models.Ticket{}).Where("sprint_id = ?", id).Update("sprint_id", nil).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(sprint).Error
+	})
+}
+
+func (r *SprintRepository) StartSprint(ctx *fiber.Ctx, projectID uint, id uint, goal string, startDate time.Time, endDate time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the project row so two sprints cannot be started at the same time
+		var project models.Project
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&project, projectID).Error; err != nil {
+			return err
+		}
+
+		sprint, err := r.lockSprint(tx, projectID, id)
+		if err != nil {
+			return err
+		}
+		if sprint.State != domain.SprintStatePlanned {
+			return domain.ErrSprintNotPlanned
+		}
+
+		var active int64
+		if err := tx.Model(&models.Sprint{}).Where("project_id = ? AND state = ?", projectID, domain.SprintStateActive).Count(&active).Error; err != nil {
+			return err
+		}
+		if active > 0 {
+			return domain.ErrSprintAlreadyActive
+		}
+
+		var committed int
+		if err := sprintPoints(tx, id).Scan(&committed).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(sprint).Updates(map[string]interface{}{
+			"state":            domain.SprintStateActive,
+			"goal":             goal,
+			"start_date":       startDate,
+			"end_date":         endDate,
+			"started_at":       time.Now(),
+			"committed_points": committed,
+		}).Error
+	})
+}
+
+func (r *SprintRepository) CompleteSprint(ctx *fiber.Ctx, projectID uint, id uint, moveTo *uint) ([]uint, error) {
+	var moved []uint
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		sprint, err := r.lockSprint(tx, projectID, id)
+		if err != nil {
+			return err
+		}
+		if sprint.State != domain.SprintStateActive {
+			return domain.ErrSprintNotActive
+		}
+
+		var completed int
+		resolved := sprintPoints(tx, id).
+			Joins("JOIN ticket_statuses ON ticket_statuses.id = tickets.status_id").
+			Where("ticket_statuses.is_resolved = ?", true)
+		if err := resolved.Scan(&completed).Error; err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.Ticket{}).
+			Joins("JOIN ticket_statuses ON ticket_statuses.id = tickets.status_id").
+			Where("tickets.sprint_id = ? AND ticket_statuses.is_resolved = ?", id, false).
+			Order("tickets.id").
+			Pluck("tickets.id", &moved).Error
+		if err != nil {
+			return err
+		}
+
+		if len(moved) > 0 {
+			if err := tx.Model(&models.Ticket{}).Where("id IN ?", moved).Update("sprint_id", moveTo).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(sprint).Updates(map[string]interface{}{
+			"state":            domain.SprintStateCompleted,
+			"completed_at":     time.Now(),
+			"completed_points": completed,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return moved, nil
+}
+
+func (r *SprintRepository) AddSprintTickets(ctx *fiber.Ctx, projectID uint, id uint, ticketIDs []uint) (map[uint]*uint, error) {
+	moved := map[uint]*uint{}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		sprint, err := r.lockSprint(tx, projectID, id)
+		if err != nil {
+			return err
+		}
+		if sprint.State == domain.SprintStateCompleted {
+			return domain.ErrSprintCompleted
+		}
+
+		// Completing a sprint also locks the sprint before its tickets
+		var tickets []models.Ticket
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "sprint_id").
+			Where("project_id = ? AND id IN ?", projectID, ticketIDs).
+			Order("id").
+			Find(&tickets).Error
+		if err != nil {
+			return err
+		}
+
+		ids := make([]uint, 0, len(tickets))
+		var sources []uint
+		found := map[uint]bool{}
+		for _, ticket := range tickets {
+			found[ticket.ID] = true
+			if ticket.SprintID != nil && *ticket.SprintID == id {
+				continue
+			}
+			ids = append(ids, ticket.ID)
+			moved[ticket.ID] = ticket.SprintID
+			if ticket.SprintID != nil {
+				sources = append(sources, *ticket.SprintID)
+			}
+		}
+		for _, ticketID := range ticketIDs {
+			if !found[ticketID] {
+				return gorm.ErrRecordNotFound
+			}
+		}
+
+		if len(sources) > 0 {
+			var completed models.Sprint
+			err := tx.Where("id IN ? AND state = ?", sources, domain.SprintStateCompleted).Limit(1).Find(&completed).Error
+			if err != nil {
+				return err
+			}
+			if completed.ID != 0 {
+				return fmt.Errorf("%w: its tickets cannot be moved to another sprint (%s)", domain.ErrSprintCompleted, completed.Name)
+			}
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+		return tx.Model(&models.Ticket{}).Where("id IN ?", ids).Update("sprint_id", id).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return moved, nil
+}
+
+func (r *SprintRepository) SetTicketSprint(ctx *fiber.Ctx, projectID uint, ticketID uint, sprintID *uint) error {
+	result := r.db.Model(&models.Ticket{}).Where("id = ? AND project_id = ?", ticketID, projectID).Update("sprint_id", sprintID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// lockSprint loads the sprint for update so state changes are serialized
+func (r *SprintRepository) lockSprint(tx *gorm.DB, projectID uint, id uint) (*models.Sprint, error) {
+	var sprint models.Sprint
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("project_id = ?", projectID).First(&sprint, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &sprint, nil
+}
+
+// sprintPoints sums the story points of the tickets in the sprint
+func sprintPoints(tx *gorm.DB, sprintID uint) *gorm.DB {
+	return tx.Model(&models.Ticket{}).
+		Select("COALESCE(SUM(tickets.story_points), 0)").
+		Where("tickets.sprint_id = ?", sprintID)
+}
+
+func sprintModelToDomain(model *models.Sprint) *domain.Sprint {
+	return &domain.Sprint{
+		ID:              model.ID,
+		ProjectID:       model.ProjectID,
+		Name:            model.Name,
+		Goal:            model.Goal,
+		State:           model.State,
+		StartDate:       model.StartDate,
+		EndDate:         model.EndDate,
+		StartedAt:       model.StartedAt,
+		CompletedAt:     model.CompletedAt,
+		CommittedPoints: model.CommittedPoints,
+		CompletedPoints: model.CompletedPoints,
+		CreatedAt:       model.CreatedAt,
+		UpdatedAt:       model.UpdatedAt,
+	}
+}
@@ -0,0 +1,684 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"task-management/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ticketPreloads = []string{"Type", "Status", "Priority", "Assignee", "Reporter", "Labels", "Components", "Versions.Version"}
+
+type TicketRepository struct {
+	db *gorm.DB
+}
+
+func NewTicketRepository(db *gorm.DB) *TicketRepository {
+	return &TicketRepository{db: db}
+}
+
+// GetTickets lists the tickets of the project. label_ids=1,2 only keeps the
+// tickets that have any of the labels.
+func (r *TicketRepository) GetTickets(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Ticket, error) {
+	query := r.db.Where("project_id = ?", projectID)
+
+	if value := ctx.Query("label_ids"); value != "" {
+		labelIDs, err := parseIDs(value)
+		if err != nil {
+			return 0, 0, 0, nil, errors.New("label_ids must be a comma separated list of numbers")
+		}
+		query = query.Where("id IN (SELECT ticket_id FROM ticket_labels WHERE label_id IN ?)", labelIDs)
+	}
+
+	total, page, limit, tickets, err := util.FindAll[models.Ticket](ctx, query, ticketPreloads...)
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	result := make([]*domain.Ticket, len(tickets))
+	for i, ticket := range tickets {
+		result[i] = ticketModelToDomain(&ticket)
+	}
+	if err := r.attachRollups(result...); err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	return total, page, limit, result, nil
+}
+
+func (r *TicketRepository) GetTicketByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Ticket, error) {
+	query := r.db.Where("project_id = ?", projectID)
+
+	ticket, err := util.FindOne[models.Ticket](ctx, query, int64(id), ticketPreloads...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := ticketModelToDomain(ticket)
+	if err := r.attachRollups(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *TicketRepository) GetTicketByKey(ctx *fiber.Ctx, key string) (*domain.Ticket, error) {
+	query := r.db.Joins("JOIN projects ON projects.id = tickets.project_id AND projects.deleted_at IS NULL").
+		Where("projects.organization_id = ?", ctx.Locals("organization_id"))
+
+	ticket, err := util.FindOneByCondition[models.Ticket](ctx, query, "tickets.ticket_key", key, ticketPreloads...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := ticketModelToDomain(ticket)
+	if err := r.attachRollups(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *TicketRepository) CreateTicket(ctx *fiber.Ctx, ticket *domain.Ticket) error {
+	ticketModel := models.Ticket{
+		ProjectID:      ticket.ProjectID,
+		Title:          ticket.Title,
+		Description:    ticket.Description,
+		TypeID:         ticket.TypeID,
+		StatusID:       ticket.StatusID,
+		PriorityID:     ticket.PriorityID,
+		AssigneeID:     ticket.AssigneeID,
+		ReporterID:     ticket.ReporterID,
+		ParentID:       ticket.ParentID,
+		EstimatedHours: ticket.EstimatedHours,
+		DueDate:        ticket.DueDate,
+		StoryPoints:    ticket.StoryPoints,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the project row so concurrent inserts get consecutive numbers,
+		// a rolled back insert also rolls back the counter so there are no gaps
+		var project models.Project
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "key", "ticket_counter").
+			First(&project, ticket.ProjectID).Error
+		if err != nil {
+			return err
+		}
+
+		number := project.TicketCounter + 1
+		if err := tx.Model(&project).Update("ticket_counter", number).Error; err != nil {
+			return err
+		}
+
+		ticketModel.TicketNumber = number
+		ticketModel.TicketKey = fmt.Sprintf("%s-%d", project.Key, number)
+
+		// New tickets go to the end of the backlog, the project lock also
+		// keeps two tickets from getting the same rank
+		var lastRank string
+		if err := tx.Model(&models.Ticket{}).Where("project_id = ?", ticket.ProjectID).Select("COALESCE(MAX(rank), '')").Scan(&lastRank).Error; err != nil {
+			return err
+		}
+		ticketModel.Rank = util.RankBetween(lastRank, "")
+
+		if err := tx.Create(&ticketModel).Error; err != nil {
+			return err
+		}
+
+		for _, component := range ticket.Components {
+			err := tx.Exec("INSERT INTO ticket_components (ticket_id, component_id) VALUES (?, ?)", ticketModel.ID, component.ID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		// The initial status opens the history so lead time starts at creation
+		return r.createStatusHistory(tx, &domain.TicketStatusHistory{
+			TicketID:   ticketModel.ID,
+			ProjectID:  ticket.ProjectID,
+			ToStatusID: ticket.StatusID,
+			ChangedBy:  ticket.ReporterID,
+			ChangedAt:  ticketModel.CreatedAt,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	ticket.ID = ticketModel.ID
+	ticket.TicketKey = ticketModel.TicketKey
+	ticket.TicketNumber = ticketModel.TicketNumber
+	ticket.Rank = ticketModel.Rank
+	ticket.CreatedAt = ticketModel.CreatedAt
+	ticket.UpdatedAt = ticketModel.UpdatedAt
+
+	return nil
+}
+
+func (r *TicketRepository) UpdateTicket(ctx *fiber.Ctx, projectID uint, id uint, ticket *domain.UpdateTicketRequest) (*domain.Ticket, error) {
+	updates := map[string]interface{}{}
+
+	if ticket.Title != nil {
+		updates["title"] = *ticket.Title
+	}
+	if ticket.Description != nil {
+		updates["description"] = *ticket.Description
+	}
+	if ticket.TypeID != nil {
+		updates["type_id"] = *ticket.TypeID
+	}
+	if ticket.PriorityID != nil {
+		updates["priority_id"] = *ticket.PriorityID
+	}
+	if ticket.AssigneeID != nil {
+		updates["assignee_id"] = nullableID(*ticket.AssigneeID)
+	}
+	if ticket.ParentID != nil {
+		updates["parent_id"] = nullableID(*ticket.ParentID)
+	}
+	if ticket.EstimatedHours != nil {
+		updates["estimated_hours"] = *ticket.EstimatedHours
+	}
+	if ticket.DueDate != nil {
+		updates["due_date"] = *ticket.DueDate
+	}
+	if ticket.StoryPoints != nil {
+		updates["story_points"] = *ticket.StoryPoints
+	}
+
+	if len(updates) > 0 {
+		result := r.db.Model(&models.Ticket{}).Where("id = ? AND project_id = ?", id, projectID).Updates(updates)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil, gorm.ErrRecordNotFound
+		}
+	}
+
+	return r.GetTicketByID(ctx, projectID, id)
+}
+
+// TransitionTicket changes the status and records the history entry in the same
+// transaction so the history never misses a transition.
+func (r *TicketRepository) TransitionTicket(ctx *fiber.Ctx, projectID uint, id uint, change *domain.TicketStatusChange, history *domain.TicketStatusHistory, checkWIP func([]*domain.ColumnWIP) error) (*domain.Ticket, error) {
+	updates := map[string]interface{}{
+		"status_id":     change.StatusID,
+		"resolution_id": change.ResolutionID,
+		"resolved_at":   change.ResolvedAt,
+		"resolved_by":   change.ResolvedBy,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the project row like ticket inserts so two transitions cannot
+		// both count the last free place of a limited column
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&models.Project{}, projectID).Error
+		if err != nil {
+			return err
+		}
+
+		columns, err := columnsEntered(tx, projectID, id, *history.FromStatusID, change.StatusID)
+		if err != nil {
+			return err
+		}
+		if err := checkWIP(columns); err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.Ticket{}).Where("id = ? AND project_id = ?", id, projectID).Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return r.createStatusHistory(tx, history)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetTicketByID(ctx, projectID, id)
+}
+
+func (r *TicketRepository) DeleteTicket(ctx *fiber.Ctx, projectID uint, id uint) error {
+	result := r.db.Where("id = ? AND project_id = ?", id, projectID).Delete(&models.Ticket{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *TicketRepository) GetStatusHistory(ctx *fiber.Ctx, projectID uint, ticketID uint) (int64, int64, int64, []*domain.TicketStatusHistory, error) {
+	query := r.db.Where("project_id = ? AND ticket_id = ?", projectID, ticketID)
+	return r.findStatusHistory(ctx, query)
+}
+
+func (r *TicketRepository) GetProjectStatusHistory(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.TicketStatusHistory, error) {
+	query := r.db.Where("project_id = ?", projectID)
+	return r.findStatusHistory(ctx, query)
+}
+
+func (r *TicketRepository) createStatusHistory(db *gorm.DB, history *domain.TicketStatusHistory) error {
+	historyModel := models.TicketStatusHistory{
+		TicketID:     history.TicketID,
+		ProjectID:    history.ProjectID,
+		FromStatusID: history.FromStatusID,
+		ToStatusID:   history.ToStatusID,
+		ChangedBy:    history.ChangedBy,
+		ChangedAt:    history.ChangedAt,
+	}
+
+	if err := db.Create(&historyModel).Error; err != nil {
+		return err
+	}
+
+	history.ID = historyModel.ID
+	return nil
+}
+
+func (r *TicketRepository) findStatusHistory(ctx *fiber.Ctx, query *gorm.DB) (int64, int64, int64, []*domain.TicketStatusHistory, error) {
+	total, page, limit, entries, err := util.FindAll[models.TicketStatusHistory](ctx, query, "FromStatus", "ToStatus")
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	result := make([]*domain.TicketStatusHistory, len(entries))
+	for i, entry := range entries {
+		result[i] = &domain.TicketStatusHistory{
+			ID:           entry.ID,
+			TicketID:     entry.TicketID,
+			ProjectID:    entry.ProjectID,
+			FromStatusID: entry.FromStatusID,
+			ToStatusID:   entry.ToStatusID,
+			ChangedBy:    entry.ChangedBy,
+			ChangedAt:    entry.ChangedAt,
+			ToStatus:     ticketStatusModelToDomain(&entry.ToStatus),
+		}
+		if entry.FromStatus != nil {
+			result[i].FromStatus = ticketStatusModelToDomain(entry.FromStatus)
+		}
+	}
+
+	return total, page, limit, result, nil
+}
+
+func (r *TicketRepository) GetTicketStatuses(ctx *fiber.Ctx, projectID uint) ([]*domain.TicketStatus, error) {
+	var statuses []models.TicketStatus
+	if err := r.db.Where("project_id = ?", projectID).Order("position asc").Find(&statuses).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.TicketStatus, len(statuses))
+	for i, status := range statuses {
+		result[i] = ticketStatusModelToDomain(&status)
+	}
+	return result, nil
+}
+
+func (r *TicketRepository) GetTicketStatusByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.TicketStatus, error) {
+	var status models.TicketStatus
+	if err := r.db.Where("project_id = ?", projectID).First(&status, id).Error; err != nil {
+		return nil, err
+	}
+	return ticketStatusModelToDomain(&status), nil
+}
+
+func (r *TicketRepository) GetDefaultTicketStatus(ctx *fiber.Ctx, projectID uint) (*domain.TicketStatus, error) {
+	var status models.TicketStatus
+	err := r.db.Where("project_id = ? AND is_active = ?", projectID, true).
+		Order("is_default desc").Order("position asc").
+		First(&status).Error
+	if err != nil {
+		return nil, err
+	}
+	return ticketStatusModelToDomain(&status), nil
+}
+
+func (r *TicketRepository) GetTicketTypes(ctx *fiber.Ctx) ([]*domain.TicketType, error) {
+	var types []models.TicketType
+	if err := r.db.Where("is_active = ?", true).Order("position asc").Find(&types).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.TicketType, len(types))
+	for i, ticketType := range types {
+		result[i] = ticketTypeModelToDomain(&ticketType)
+	}
+	return result, nil
+}
+
+func (r *TicketRepository) GetTicketTypeByID(ctx *fiber.Ctx, id uint) (*domain.TicketType, error) {
+	var ticketType models.TicketType
+	if err := r.db.Where("is_active = ?", true).First(&ticketType, id).Error; err != nil {
+		return nil, err
+	}
+	return ticketTypeModelToDomain(&ticketType), nil
+}
+
+func (r *TicketRepository) GetPriorities(ctx *fiber.Ctx) ([]*domain.Priority, error) {
+	var priorities []models.Priority
+	if err := r.db.Where("is_active = ?", true).Order("level asc").Find(&priorities).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Priority, len(priorities))
+	for i, priority := range priorities {
+		result[i] = priorityModelToDomain(&priority)
+	}
+	return result, nil
+}
+
+func (r *TicketRepository) GetPriorityByID(ctx *fiber.Ctx, id uint) (*domain.Priority, error) {
+	var priority models.Priority
+	if err := r.db.Where("is_active = ?", true).First(&priority, id).Error; err != nil {
+		return nil, err
+	}
+	return priorityModelToDomain(&priority), nil
+}
+
+func (r *TicketRepository) IsProjectMember(ctx *fiber.Ctx, projectID uint, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.ProjectMember{}).
+		Where("project_id = ? AND user_id = ?", projectID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *TicketRepository) GetNeighbourRank(ctx *fiber.Ctx, projectID uint, rank string, before bool, excludeID uint) (string, error) {
+	query := r.db.Model(&models.Ticket{}).Where("project_id = ? AND id <> ? AND rank <> ''", projectID, excludeID)
+	if before {
+		query = query.Where("rank < ?", rank).Select("COALESCE(MAX(rank), '')")
+	} else {
+		query = query.Where("rank > ?", rank).Select("COALESCE(MIN(rank), '')")
+	}
+
+	var neighbour string
+	if err := query.Scan(&neighbour).Error; err != nil {
+		return "", err
+	}
+	return neighbour, nil
+}
+
+func (r *TicketRepository) UpdateTicketRank(ctx *fiber.Ctx, projectID uint, id uint, rank string) error {
+	result := r.db.Model(&models.Ticket{}).Where("id = ? AND project_id = ?", id, projectID).Update("rank", rank)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *TicketRepository) RebalanceRanks(ctx context.Context, projectID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// CreateTicket takes the same lock, no ticket is appended after an old rank meanwhile
+		var project models.Project
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&project, projectID).Error; err != nil {
+			return err
+		}
+
+		// Unranked tickets keep their creation order after the ranked ones
+		var ids []uint
+		err := tx.Model(&models.Ticket{}).
+			Where("project_id = ?", projectID).
+			Order("rank = '' ASC, rank ASC, id ASC").
+			Pluck("id", &ids).Error
+		if err != nil {
+			return err
+		}
+
+		// The rank is not a change of the ticket, updated_at is left alone
+		for i, rank := range util.EvenRanks(len(ids)) {
+			if err := tx.Model(&models.Ticket{}).Where("id = ?", ids[i]).UpdateColumn("rank", rank).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *TicketRepository) GetProjectsToRebalance(ctx context.Context, maxLength int) ([]uint, error) {
+	var projectIDs []uint
+	err := r.db.WithContext(ctx).Raw(`SELECT project_id FROM tickets
+WHERE deleted_at IS NULL AND (rank = '' OR LENGTH(rank) > ?)
+UNION
+SELECT project_id FROM tickets
+WHERE deleted_at IS NULL AND rank <> ''
+GROUP BY project_id, rank HAVING COUNT(*) > 1`, maxLength).Scan(&projectIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return projectIDs, nil
+}
+
+func (r *TicketRepository) GetChildren(ctx *fiber.Ctx, projectID uint, id uint) ([]*domain.Ticket, error) {
+	var children []models.Ticket
+	if err := r.db.Where("project_id = ? AND parent_id = ?", projectID, id).Preload("Type").Order("id ASC").Find(&children).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Ticket, len(children))
+	for i, child := range children {
+		result[i] = ticketModelToDomain(&child)
+	}
+	return result, nil
+}
+
+func (r *TicketRepository) GetAncestorIDs(ctx *fiber.Ctx, id uint) ([]uint, error) {
+	// UNION stops on parent cycles left by older data
+	var ids []uint
+	err := r.db.Raw(`WITH RECURSIVE ancestors(id) AS (
+	SELECT parent_id FROM tickets WHERE id = ? AND parent_id IS NOT NULL
+	UNION
+	SELECT tickets.parent_id FROM tickets
+	JOIN ancestors ON tickets.id = ancestors.id
+	WHERE tickets.parent_id IS NOT NULL AND tickets.deleted_at IS NULL
+)
+SELECT id FROM ancestors`, id).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// attachRollups sums the descendants of the tickets in one recursive query,
+// they are computed on read so they follow every change of the children. The
+// estimate of a ticket with children is split among them, so story points and
+// estimated hours only add up the leaves.
+func (r *TicketRepository) attachRollups(tickets ...*domain.Ticket) error {
+	if len(tickets) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, len(tickets))
+	for i, ticket := range tickets {
+		ids[i] = ticket.ID
+	}
+
+	var rows []struct {
+		RootID         uint
+		Descendants    int
+		Resolved       int
+		StoryPoints    int
+		EstimatedHours float64
+		ActualHours    float64
+	}
+	err := r.db.Raw(`WITH RECURSIVE tree(root_id, id) AS (
+	SELECT parent_id, id FROM tickets WHERE parent_id IN ? AND deleted_at IS NULL
+	UNION
+	SELECT tree.root_id, tickets.id FROM tickets
+	JOIN tree ON tickets.parent_id = tree.id
+	WHERE tickets.deleted_at IS NULL
+)
+SELECT tree.root_id,
+	COUNT(*) AS descendants,
+	COUNT(tickets.resolved_at) AS resolved,
+	COALESCE(SUM(tickets.story_points) FILTER (WHERE node.leaf), 0) AS story_points,
+	COALESCE(SUM(tickets.estimated_hours) FILTER (WHERE node.leaf), 0) AS estimated_hours,
+	COALESCE(SUM(tickets.actual_hours), 0) AS actual_hours
+FROM tree
+JOIN tickets ON tickets.id = tree.id AND tickets.id <> tree.root_id
+CROSS JOIN LATERAL (SELECT NOT EXISTS (SELECT 1 FROM tickets children
+	WHERE children.parent_id = tickets.id AND children.deleted_at IS NULL) AS leaf) node
+GROUP BY tree.root_id`, ids).Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	rollups := make(map[uint]*domain.TicketRollup, len(rows))
+	for _, row := range rows {
+		rollups[row.RootID] = &domain.TicketRollup{
+			Descendants:    row.Descendants,
+			Resolved:       row.Resolved,
+			StoryPoints:    row.StoryPoints,
+			EstimatedHours: row.EstimatedHours,
+			ActualHours:    row.ActualHours,
+			PercentDone:    row.Resolved * 100 / row.Descendants,
+		}
+	}
+	for _, ticket := range tickets {
+		ticket.Rollup = rollups[ticket.ID]
+	}
+	return nil
+}
+
+// parseIDs parses a comma separated list of IDs, e.g. label_ids=1,2
+func parseIDs(value string) ([]uint, error) {
+	parts := strings.Split(value, ",")
+	ids := make([]uint, len(parts))
+	for i, part := range parts {
+		id, err := strconv.ParseUint(strings.TrimSpace(part), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = uint(id)
+	}
+	return ids, nil
+}
+
+// nullableID turns a zero ID into NULL for nullable foreign keys.
+func nullableID(id uint) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+func ticketModelToDomain(model *models.Ticket) *domain.Ticket {
+	ticket := &domain.Ticket{
+		ID:             model.ID,
+		ProjectID:      model.ProjectID,
+		Title:          model.Title,
+		Description:    model.Description,
+		TicketKey:      model.TicketKey,
+		TicketNumber:   model.TicketNumber,
+		TypeID:         model.TypeID,
+		StatusID:       model.StatusID,
+		PriorityID:     model.PriorityID,
+		AssigneeID:     model.AssigneeID,
+		ReporterID:     model.ReporterID,
+		ParentID:       model.ParentID,
+		EstimatedHours: model.EstimatedHours,
+		ActualHours:    model.ActualHours,
+		DueDate:        model.DueDate,
+		StoryPoints:    model.StoryPoints,
+		SprintID:       model.SprintID,
+		Rank:           model.Rank,
+		ResolutionID:   model.ResolutionID,
+		ResolvedAt:     model.ResolvedAt,
+		ResolvedBy:     model.ResolvedBy,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}
+
+	// Relationships are only mapped when they were preloaded
+	if model.Type.ID != 0 {
+		ticket.Type = ticketTypeModelToDomain(&model.Type)
+	}
+	if model.Status.ID != 0 {
+		ticket.Status = ticketStatusModelToDomain(&model.Status)
+	}
+	if model.Priority.ID != 0 {
+		ticket.Priority = priorityModelToDomain(&model.Priority)
+	}
+	if model.Assignee != nil {
+		ticket.Assignee = userModelToDomain(model.Assignee)
+	}
+	if model.Reporter.ID != 0 {
+		ticket.Reporter = userModelToDomain(&model.Reporter)
+	}
+	for _, label := range model.Labels {
+		ticket.Labels = append(ticket.Labels, labelModelToDomain(&label))
+	}
+	for _, component := range model.Components {
+		ticket.Components = append(ticket.Components, componentModelToDomain(&component))
+	}
+	for _, link := range model.Versions {
+		if link.Version.ID == 0 {
+			continue
+		}
+		version := versionModelToDomain(&link.Version)
+		if link.Relation == domain.VersionRelationFix {
+			ticket.FixVersions = append(ticket.FixVersions, version)
+		} else {
+			ticket.AffectsVersions = append(ticket.AffectsVersions, version)
+		}
+	}
+
+	return ticket
+}
+
+func ticketStatusModelToDomain(model *models.TicketStatus) *domain.TicketStatus {
+	return &domain.TicketStatus{
+		ID:          model.ID,
+		ProjectID:   model.ProjectID,
+		Name:        model.Name,
+		Description: model.Description,
+		Color:       model.Color,
+		Position:    model.Position,
+		IsDefault:   model.IsDefault,
+		IsActive:    model.IsActive,
+		IsClosed:    model.IsClosed,
+		IsResolved:  model.IsResolved,
+	}
+}
+
+func ticketTypeModelToDomain(model *models.TicketType) *domain.TicketType {
+	return &domain.TicketType{
+		ID:             model.ID,
+		Name:           model.Name,
+		Description:    model.Description,
+		Icon:           model.Icon,
+		Color:          model.Color,
+		IsActive:       model.IsActive,
+		Position:       model.Position,
+		HierarchyLevel: model.HierarchyLevel,
+	}
+}
+
+func priorityModelToDomain(model *models.Priority) *domain.Priority {
+	return &domain.Priority{
+		ID:          model.ID,
+		Name:        model.Name,
+		Description: model.Description,
+		Color:       model.Color,
+		Level:       model.Level,
+		IsActive:    model.IsActive,
+	}
+}
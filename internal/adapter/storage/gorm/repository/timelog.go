@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"task-management/internal/util"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TimeLogRepository struct {
+	db *gorm.DB
+}
+
+func NewTimeLogRepository(db *gorm.DB) *TimeLogRepository {
+	return &TimeLogRepository{db: db}
+}
+
+func (r *TimeLogRepository) GetTimeLogs(ctx *fiber.Ctx, ticketID uint) (int64, int64, int64, []*domain.TimeLog, error) {
+	query := r.db.Where("ticket_id = ?", ticketID)
+
+	total, page, limit, logs, err := util.FindAll[models.TimeLog](ctx, query, "User")
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	result := make([]*domain.TimeLog, len(logs))
+	for i, log := range logs {
+		result[i] = timeLogModelToDomain(&log)
+	}
+	return total, page, limit, result, nil
+}
+
+func (r *TimeLogRepository) GetTimeLogByID(ctx *fiber.Ctx, ticketID uint, id uint) (*domain.TimeLog, error) {
+	var log models.TimeLog
+	if err := r.db.Where("ticket_id = ?", ticketID).Preload("User").First(&log, id).Error; err != nil {
+		return nil, err
+	}
+	return timeLogModelToDomain(&log), nil
+}
+
+func (r *TimeLogRepository) CreateTimeLog(ctx *fiber.Ctx, log *domain.TimeLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return createTimeLog(tx, log)
+	})
+}
+
+func (r *TimeLogRepository) UpdateTimeLog(ctx *fiber.Ctx, ticketID uint, id uint, req *domain.UpdateTimeLogRequest) (*domain.TimeLog, error) {
+	updates := map[string]interface{}{}
+	if req.Hours != nil {
+		updates["hours"] = *req.Hours
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.LoggedDate != nil {
+		updates["logged_date"] = *req.LoggedDate
+	}
+
+	if len(updates) > 0 {
+		err := r.db.Transaction(func(tx *gorm.DB) error {
+			if err := lockTicket(tx, ticketID); err != nil {
+				return err
+			}
+
+			var log models.TimeLog
+			if err := tx.Where("ticket_id = ?", ticketID).First(&log, id).Error; err != nil {
+				return err
+			}
+
+			// Moving a log needs both weeks to be open
+			dates := []time.Time{log.LoggedDate}
+			if req.LoggedDate != nil {
+				dates = append(dates, *req.LoggedDate)
+			}
+			if err := checkWeeksUnlocked(tx, ticketID, log.UserID, dates...); err != nil {
+				return err
+			}
+
+			if err := tx.Model(&log).Updates(updates).Error; err != nil {
+				return err
+			}
+
+			return syncActualHours(tx, ticketID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r.GetTimeLogByID(ctx, ticketID, id)
+}
+
+func (r *TimeLogRepository) DeleteTimeLog(ctx *fiber.Ctx, ticketID uint, id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockTicket(tx, ticketID); err != nil {
+			return err
+		}
+
+		var log models.TimeLog
+		if err := tx.Where("ticket_id = ?", ticketID).First(&log, id).Error; err != nil {
+			return err
+		}
+		if err := checkWeeksUnlocked(tx, ticketID, log.UserID, log.LoggedDate); err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&log).Error; err != nil {
+			return err
+		}
+
+		return syncActualHours(tx, ticketID)
+	})
+}
+
+func (r *TimeLogRepository) GetTimer(ctx *fiber.Ctx, userID uint) (*domain.Timer, error) {
+	var timer models.Timer
+	if err := r.db.Where("user_id = ?", userID).Preload("Ticket").First(&timer).Error; err != nil {
+		return nil, err
+	}
+	return timerModelToDomain(&timer), nil
+}
+
+func (r *TimeLogRepository) StartTimer(ctx *fiber.Ctx, timer *domain.Timer) error {
+	timerModel := models.Timer{
+		UserID:      timer.UserID,
+		TicketID:    timer.TicketID,
+		Description: timer.Description,
+		StartedAt:   timer.StartedAt,
+	}
+
+	// The unique user_id keeps a single timer per user
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&timerModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrTimerRunning
+	}
+
+	timer.ID = timerModel.ID
+	return nil
+}
+
+func (r *TimeLogRepository) StopTimer(ctx *fiber.Ctx, timerID uint, log *domain.TimeLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// A timer stopped twice at the same time only creates one log
+		result := tx.Delete(&models.Timer{}, timerID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrTimerNotRunning
+		}
+
+		return createTimeLog(tx, log)
+	})
+}
+
+func (r *TimeLogRepository) DeleteTimer(ctx *fiber.Ctx, timerID uint) error {
+	result := r.db.Delete(&models.Timer{}, timerID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrTimerNotRunning
+	}
+	return nil
+}
+
+func createTimeLog(tx *gorm.DB, log *domain.TimeLog) error {
+	if err := lockTicket(tx, log.TicketID); err != nil {
+		return err
+	}
+	if err := checkWeeksUnlocked(tx, log.TicketID, log.UserID, log.LoggedDate); err != nil {
+		return err
+	}
+
+	logModel := models.TimeLog{
+		TicketID:    log.TicketID,
+		UserID:      log.UserID,
+		Hours:       log.Hours,
+		Description: log.Description,
+		LoggedDate:  log.LoggedDate,
+	}
+	if err := tx.Create(&logModel).Error; err != nil {
+		return err
+	}
+
+	log.ID = logModel.ID
+	log.CreatedAt = logModel.CreatedAt
+	log.UpdatedAt = logModel.UpdatedAt
+
+	return syncActualHours(tx, log.TicketID)
+}
+
+// lockTicket serializes the changes of the time logs of a ticket, so the sum
+// written by syncActualHours sees the logs committed before it
+func lockTicket(tx *gorm.DB, ticketID uint) error {
+	var ticket models.Ticket
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&ticket, ticketID).Error
+}
+
+// syncActualHours sets the actual hours of the ticket to the sum of its time
+// logs, NULL when it has none. It is not a change of the ticket, updated_at is
+// left alone.
+func syncActualHours(tx *gorm.DB, ticketID uint) error {
+	return tx.Exec(`UPDATE tickets SET actual_hours = (
+	SELECT SUM(hours) FROM time_logs WHERE ticket_id = ? AND deleted_at IS NULL
+) WHERE id = ?`, ticketID, ticketID).Error
+}
+
+func timeLogModelToDomain(model *models.TimeLog) *domain.TimeLog {
+	log := &domain.TimeLog{
+		ID:          model.ID,
+		TicketID:    model.TicketID,
+		UserID:      model.UserID,
+		Hours:       model.Hours,
+		Description: model.Description,
+		LoggedDate:  model.LoggedDate,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+	if model.User.ID != 0 {
+		log.User = userModelToDomain(&model.User)
+	}
+	if model.Ticket.ID != 0 {
+		log.Ticket = ticketModelToDomain(&model.Ticket)
+	}
+	return log
+}
+
+func timerModelToDomain(model *models.Timer) *domain.Timer {
+	timer := &domain.Timer{
+		ID:          model.ID,
+		UserID:      model.UserID,
+		TicketID:    model.TicketID,
+		Description: model.Description,
+		StartedAt:   model.StartedAt,
+	}
+	if model.Ticket.ID != 0 {
+		timer.Ticket = ticketModelToDomain(&model.Ticket)
+	}
+	return timer
+}
@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"sort"
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"task-management/internal/util"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TimesheetRepository struct {
+	db *gorm.DB
+}
+
+func NewTimesheetRepository(db *gorm.DB) *TimesheetRepository {
+	return &TimesheetRepository{db: db}
+}
+
+func (r *TimesheetRepository) GetTimesheets(ctx *fiber.Ctx, organizationID uint, userID *uint, status string) (int64, int64, int64, []*domain.Timesheet, error) {
+	query := r.db.Where("organization_id = ?", organizationID)
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	total, page, limit, timesheets, err := util.FindAll[models.Timesheet](ctx, query, "User")
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	result := make([]*domain.Timesheet, len(timesheets))
+	for i, timesheet := range timesheets {
+		result[i] = timesheetModelToDomain(&timesheet)
+	}
+	return total, page, limit, result, nil
+}
+
+func (r *TimesheetRepository) GetTimesheetByID(ctx *fiber.Ctx, organizationID uint, id uint) (*domain.Timesheet, error) {
+	var timesheet models.Timesheet
+	if err := r.db.Where("organization_id = ?", organizationID).Preload("User").First(&timesheet, id).Error; err != nil {
+		return nil, err
+	}
+	return timesheetModelToDomain(&timesheet), nil
+}
+
+func (r *TimesheetRepository) GetTimesheetByWeek(ctx *fiber.Ctx, organizationID uint, userID uint, weekStart time.Time) (*domain.Timesheet, error) {
+	var timesheet models.Timesheet
+	err := r.db.Where("organization_id = ? AND user_id = ? AND week_start = ?", organizationID, userID, weekStart.Format(time.DateOnly)).
+		Preload("User").
+		First(&timesheet).Error
+	if err != nil {
+		return nil, err
+	}
+	return timesheetModelToDomain(&timesheet), nil
+}
+
+func (r *TimesheetRepository) GetWeekTimeLogs(ctx *fiber.Ctx, organizationID uint, userID uint, weekStart time.Time) ([]*domain.TimeLog, error) {
+	var logs []models.TimeLog
+	err := weekTimeLogs(r.db, organizationID, userID, weekStart).
+		Preload("Ticket").
+		Order("time_logs.logged_date, time_logs.id").
+		Find(&logs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.TimeLog, len(logs))
+	for i, log := range logs {
+		result[i] = timeLogModelToDomain(&log)
+	}
+	return result, nil
+}
+
+func (r *TimesheetRepository) SubmitTimesheet(ctx *fiber.Ctx, organizationID uint, userID uint, weekStart time.Time) (*domain.Timesheet, error) {
+	var timesheet models.Timesheet
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockTimesheetWeek(tx, userID, weekStart); err != nil {
+			return err
+		}
+
+		timesheet = models.Timesheet{
+			OrganizationID: organizationID,
+			UserID:         userID,
+			WeekStart:      weekStart,
+			Status:         domain.TimesheetStatusDraft,
+		}
+		err := tx.Where("organization_id = ? AND user_id = ? AND week_start = ?", organizationID, userID, weekStart.Format(time.DateOnly)).
+			FirstOrCreate(&timesheet).Error
+		if err != nil {
+			return err
+		}
+		if timesheet.Status == domain.TimesheetStatusSubmitted || timesheet.Status == domain.TimesheetStatusApproved {
+			return domain.ErrTimesheetLocked
+		}
+
+		var total float64
+		if err := weekTimeLogs(tx, organizationID, userID, weekStart).Select("COALESCE(SUM(time_logs.hours), 0)").Scan(&total).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&timesheet).Updates(map[string]interface{}{
+			"status":         domain.TimesheetStatusSubmitted,
+			"total_hours":    total,
+			"submitted_at":   time.Now(),
+			"reviewed_by":    nil,
+			"reviewed_at":    nil,
+			"review_comment": "",
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetTimesheetByID(ctx, organizationID, timesheet.ID)
+}
+
+func (r *TimesheetRepository) ReviewTimesheet(ctx *fiber.Ctx, organizationID uint, id uint, status string, reviewerID uint, comment string) (*domain.Timesheet, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var timesheet models.Timesheet
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("organization_id = ?", organizationID).First(&timesheet, id).Error
+		if err != nil {
+			return err
+		}
+		if timesheet.Status != domain.TimesheetStatusSubmitted {
+			return domain.ErrTimesheetNotSubmitted
+		}
+
+		return tx.Model(&timesheet).Updates(map[string]interface{}{
+			"status":         status,
+			"reviewed_by":    reviewerID,
+			"reviewed_at":    time.Now(),
+			"review_comment": comment,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetTimesheetByID(ctx, organizationID, id)
+}
+
+// weekTimeLogs selects the time logs of the user in the week, on the tickets of
+// the organization
+func weekTimeLogs(tx *gorm.DB, organizationID uint, userID uint, weekStart time.Time) *gorm.DB {
+	return tx.Model(&models.TimeLog{}).
+		Joins("JOIN tickets ON tickets.id = time_logs.ticket_id").
+		Joins("JOIN projects ON projects.id = tickets.project_id").
+		Where("time_logs.user_id = ? AND projects.organization_id = ?", userID, organizationID).
+		Where("time_logs.logged_date >= ? AND time_logs.logged_date < ?", weekStart, weekStart.AddDate(0, 0, 7))
+}
+
+// lockTimesheetWeek serializes the submission of a week with the changes of
+// its time logs, so a log cannot be written while the week is being locked
+func lockTimesheetWeek(tx *gorm.DB, userID uint, weekStart time.Time) error {
+	return tx.Exec("SELECT pg_advisory_xact_lock(?, ?)", int32(userID), int32(weekStart.Unix()/86400)).Error
+}
+
+// checkWeeksUnlocked fails with ErrTimesheetLocked when one of the dates is in
+// a submitted or approved timesheet of the user, in the organization of the
+// ticket. The weeks are locked in order so two changes cannot deadlock.
+func checkWeeksUnlocked(tx *gorm.DB, ticketID uint, userID uint, dates ...time.Time) error {
+	var weeks []time.Time
+	seen := map[time.Time]bool{}
+	for _, date := range dates {
+		week := domain.WeekStart(date)
+		if !seen[week] {
+			seen[week] = true
+			weeks = append(weeks, week)
+		}
+	}
+	sort.Slice(weeks, func(i, j int) bool { return weeks[i].Before(weeks[j]) })
+
+	days := make([]string, len(weeks))
+	for i, week := range weeks {
+		if err := lockTimesheetWeek(tx, userID, week); err != nil {
+			return err
+		}
+		days[i] = week.Format(time.DateOnly)
+	}
+
+	var locked bool
+	err := tx.Raw(`SELECT EXISTS (
+	SELECT 1 FROM timesheets
+	JOIN projects ON projects.organization_id = timesheets.organization_id
+	JOIN tickets ON tickets.project_id = projects.id
+	WHERE tickets.id = ? AND timesheets.user_id = ? AND timesheets.week_start IN ?
+	AND timesheets.status IN ? AND timesheets.deleted_at IS NULL
+)`, ticketID, userID, days, []string{domain.TimesheetStatusSubmitted, domain.TimesheetStatusApproved}).Scan(&locked).Error
+	if err != nil {
+		return err
+	}
+	if locked {
+		return domain.ErrTimesheetLocked
+	}
+	return nil
+}
+
+func timesheetModelToDomain(model *models.Timesheet) *domain.Timesheet {
+	timesheet := &domain.Timesheet{
+		ID:             model.ID,
+		OrganizationID: model.OrganizationID,
+		UserID:         model.UserID,
+		WeekStart:      model.WeekStart,
+		Status:         model.Status,
+		TotalHours:     model.TotalHours,
+		SubmittedAt:    model.SubmittedAt,
+		ReviewedBy:     model.ReviewedBy,
+		ReviewedAt:     model.ReviewedAt,
+		ReviewComment:  model.ReviewComment,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}
+	if model.User.ID != 0 {
+		timesheet.User = userModelToDomain(&model.User)
+	}
+	return timesheet
+}
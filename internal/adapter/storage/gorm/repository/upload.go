@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type UploadRepository struct {
+	db *gorm.DB
+}
+
+func NewUploadRepository(db *gorm.DB) *UploadRepository {
+	return &UploadRepository{db: db}
+}
+
+func (r *UploadRepository) CreateUpload(ctx *fiber.Ctx, upload *domain.AttachmentUpload) error {
+	uploadModel := models.AttachmentUpload{
+		UploadID:  upload.UploadID,
+		ProjectID: upload.ProjectID,
+		TicketID:  upload.TicketID,
+		UserID:    upload.UserID,
+		Filename:  upload.Filename,
+		Length:    upload.Length,
+		Checksum:  upload.Checksum,
+		HashState: upload.HashState,
+		ExpiresAt: upload.ExpiresAt,
+	}
+
+	if err := r.db.Create(&uploadModel).Error; err != nil {
+		return err
+	}
+
+	upload.ID = uploadModel.ID
+	return nil
+}
+
+func (r *UploadRepository) GetUpload(ctx *fiber.Ctx, ticketID uint, uploadID string) (*domain.AttachmentUpload, error) {
+	var upload models.AttachmentUpload
+	if err := r.db.Preload("Attachment").Where("ticket_id = ? AND upload_id = ?", ticketID, uploadID).First(&upload).Error; err != nil {
+		return nil, err
+	}
+	return r.modelToDomain(&upload), nil
+}
+
+func (r *UploadRepository) AdvanceUpload(ctx *fiber.Ctx, upload *domain.AttachmentUpload, chunkSize int64, hashState []byte, mimeType string, store func() error) error {
+	updates := map[string]interface{}{
+		"upload_offset": upload.Offset + chunkSize,
+		"chunk_count":   upload.ChunkCount + 1,
+		"hash_state":    hashState,
+		"mime_type":     mimeType,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.AttachmentUpload{}).
+			Where("id = ? AND upload_offset = ? AND completed_at IS NULL", upload.ID, upload.Offset).
+			Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrUploadOffsetMismatch
+		}
+
+		// The row stays locked while the chunk is stored, a request for the
+		// same offset waits and then fails on the offset without writing
+		return store()
+	})
+	if err != nil {
+		return err
+	}
+
+	upload.Offset += chunkSize
+	upload.ChunkCount++
+	upload.HashState = hashState
+	upload.MimeType = mimeType
+	return nil
+}
+
+func (r *UploadRepository) CompleteUpload(ctx *fiber.Ctx, upload *domain.AttachmentUpload, attachment *domain.Attachment) error {
+	attachmentModel := attachmentDomainToModel(attachment)
+
+	now := time.Now()
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&attachmentModel).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.AttachmentUpload{}).
+			Where("id = ? AND completed_at IS NULL", upload.ID).
+			Updates(map[string]interface{}{"completed_at": now, "attachment_id": attachmentModel.ID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrUploadCompleted
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	attachment.ID = attachmentModel.ID
+	attachment.CreatedAt = attachmentModel.CreatedAt
+	upload.CompletedAt = &now
+	upload.AttachmentID = &attachmentModel.ID
+	upload.Attachment = attachment
+	return nil
+}
+
+func (r *UploadRepository) DeleteUpload(ctx *fiber.Ctx, id uint) error {
+	return r.db.Delete(&models.AttachmentUpload{}, id).Error
+}
+
+func (r *UploadRepository) GetExpiredUploads(ctx context.Context, before time.Time, limit int) ([]*domain.AttachmentUpload, error) {
+	var uploads []models.AttachmentUpload
+	err := r.db.WithContext(ctx).
+		Where("completed_at IS NULL AND expires_at < ?", before).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&uploads).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.AttachmentUpload, len(uploads))
+	for i, upload := range uploads {
+		result[i] = r.modelToDomain(&upload)
+	}
+	return result, nil
+}
+
+func (r *UploadRepository) DeleteExpiredUpload(ctx context.Context, id uint, before time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND completed_at IS NULL AND expires_at < ?", id, before).
+		Delete(&models.AttachmentUpload{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *UploadRepository) modelToDomain(model *models.AttachmentUpload) *domain.AttachmentUpload {
+	upload := &domain.AttachmentUpload{
+		ID:           model.ID,
+		UploadID:     model.UploadID,
+		ProjectID:    model.ProjectID,
+		TicketID:     model.TicketID,
+		UserID:       model.UserID,
+		Filename:     model.Filename,
+		Length:       model.Length,
+		Offset:       model.UploadOffset,
+		ChunkCount:   model.ChunkCount,
+		MimeType:     model.MimeType,
+		Checksum:     model.Checksum,
+		HashState:    model.HashState,
+		ExpiresAt:    model.ExpiresAt,
+		CompletedAt:  model.CompletedAt,
+		AttachmentID: model.AttachmentID,
+	}
+	if model.Attachment != nil {
+		upload.Attachment = &domain.Attachment{
+			ID:         model.Attachment.ID,
+			TicketID:   model.Attachment.TicketID,
+			Filename:   model.Attachment.Filename,
+			FileSize:   model.Attachment.FileSize,
+			MimeType:   model.Attachment.MimeType,
+			UploadedBy: model.Attachment.UploadedBy,
+			CreatedAt:  model.Attachment.CreatedAt,
+		}
+	}
+	return upload
+}
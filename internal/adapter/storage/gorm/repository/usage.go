@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type UsageRepository struct {
+	db *gorm.DB
+}
+
+func NewUsageRepository(db *gorm.DB) *UsageRepository {
+	return &UsageRepository{db: db}
+}
+
+func (r *UsageRepository) GetUsage(ctx *fiber.Ctx, organizationID uint) (*domain.OrganizationUsage, error) {
+	var organization models.Organization
+	if err := r.db.First(&organization, organizationID).Error; err != nil {
+		return nil, err
+	}
+
+	// Organizations without uploads have no usage row yet
+	var usage models.OrganizationUsage
+	if err := r.db.Where("organization_id = ?", organizationID).Limit(1).Find(&usage).Error; err != nil {
+		return nil, err
+	}
+
+	return &domain.OrganizationUsage{
+		OrganizationID: organization.ID,
+		PlanType:       organization.PlanType,
+		StorageBytes:   usage.StorageBytes,
+		StorageQuota:   domain.StorageQuota(organization.PlanType),
+	}, nil
+}
+
+// ReserveStorage checks the quota and increments the usage in a single
+// statement, so concurrent uploads cannot exceed the quota together.
+func (r *UsageRepository) ReserveStorage(ctx *fiber.Ctx, organizationID uint, size int64, quota *int64) error {
+	err := r.db.Exec("INSERT INTO organization_usages (organization_id, storage_bytes, updated_at) VALUES (?, 0, NOW()) ON CONFLICT DO NOTHING", organizationID).Error
+	if err != nil {
+		return err
+	}
+
+	query := r.db.Model(&models.OrganizationUsage{}).Where("organization_id = ?", organizationID)
+	if quota != nil {
+		query = query.Where("storage_bytes + ? <= ?", size, *quota)
+	}
+
+	result := query.Update("storage_bytes", gorm.Expr("storage_bytes + ?", size))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrStorageQuotaExceeded
+	}
+	return nil
+}
+
+func (r *UsageRepository) ReleaseStorage(ctx *fiber.Ctx, organizationID uint, size int64) error {
+	return r.db.Model(&models.OrganizationUsage{}).
+		Where("organization_id = ?", organizationID).
+		Update("storage_bytes", gorm.Expr("GREATEST(storage_bytes - ?, 0)", size)).Error
+}
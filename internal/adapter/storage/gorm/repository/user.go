@@ -103,7 +103,50 @@ func (r *UserRepository) DeleteUser(ctx *fiber.Ctx, id uint) error {
 	return nil
 }
 
+func (r *UserRepository) GetPreferences(ctx *fiber.Ctx, userID uint) ([]*domain.UserPreference, error) {
+	var preferences []models.UserPreference
+	if err := r.db.Where("user_id = ?", userID).Order("key asc").Find(&preferences).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.UserPreference, len(preferences))
+	for i, preference := range preferences {
+		result[i] = &domain.UserPreference{Key: preference.Key, Value: preference.Value, Context: preference.Context}
+	}
+	return result, nil
+}
+
+// SetPreference creates or replaces a global (context-less) preference of a user.
+func (r *UserRepository) SetPreference(ctx *fiber.Ctx, userID uint, key string, value string) (*domain.UserPreference, error) {
+	var preference models.UserPreference
+	err := r.db.Where("user_id = ? AND key = ? AND context = ?", userID, key, "").
+		Attrs(models.UserPreference{UserID: userID, Key: key}).
+		FirstOrCreate(&preference).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.db.Model(&preference).Update("value", value).Error; err != nil {
+		return nil, err
+	}
+	preference.Value = value
+
+	return &domain.UserPreference{Key: preference.Key, Value: preference.Value, Context: preference.Context}, nil
+}
+
 func (r *UserRepository) modelToDomain(userModel *models.User) *domain.User {
+	return userModelToDomain(userModel)
+}
+
+func (r *UserRepository) modelsToDomain(userModels []models.User) []*domain.User {
+	domainUsers := make([]*domain.User, len(userModels))
+	for i, userModel := range userModels {
+		domainUsers[i] = r.modelToDomain(&userModel)
+	}
+	return domainUsers
+}
+
+func userModelToDomain(userModel *models.User) *domain.User {
 	return &domain.User{
 		ID:                 userModel.ID,
 		Email:              userModel.Email,
@@ -124,11 +167,3 @@ func (r *UserRepository) modelToDomain(userModel *models.User) *domain.User {
 		UpdatedAt:          userModel.UpdatedAt,
 	}
 }
-
-func (r *UserRepository) modelsToDomain(userModels []models.User) []*domain.User {
-	domainUsers := make([]*domain.User, len(userModels))
-	for i, userModel := range userModels {
-		domainUsers[i] = r.modelToDomain(&userModel)
-	}
-	return domainUsers
-}
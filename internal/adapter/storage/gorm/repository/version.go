@@ -0,0 +1,278 @@
+package repository
+
+import (
+	"fmt"
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"task-management/internal/util"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type VersionRepository struct {
+	db *gorm.DB
+}
+
+func NewVersionRepository(db *gorm.DB) *VersionRepository {
+	return &VersionRepository{db: db}
+}
+
+func (r *VersionRepository) GetVersions(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Version, error) {
+	query := r.db.Where("project_id = ?", projectID)
+
+	total, page, limit, versions, err := util.FindAll[models.Version](ctx, query)
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	result := make([]*domain.Version, len(versions))
+	for i, version := range versions {
+		result[i] = versionModelToDomain(&version)
+	}
+	return total, page, limit, result, nil
+}
+
+func (r *VersionRepository) GetVersionByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Version, error) {
+	var version models.Version
+	if err := r.db.Where("project_id = ?", projectID).First(&version, id).Error; err != nil {
+		return nil, err
+	}
+	return versionModelToDomain(&version), nil
+}
+
+func (r *VersionRepository) FindVersionByName(ctx *fiber.Ctx, projectID uint, name string) (*domain.Version, error) {
+	var versions []models.Version
+	if err := r.db.Where("project_id = ? AND LOWER(name) = LOWER(?)", projectID, name).Limit(1).Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, nil
+	}
+	return versionModelToDomain(&versions[0]), nil
+}
+
+func (r *VersionRepository) GetNextVersion(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Version, error) {
+	var versions []models.Version
+	err := r.db.Where("project_id = ? AND id <> ? AND is_released = ? AND is_active = ?", projectID, id, false, true).
+		Order("release_date ASC NULLS LAST, id ASC").
+		Limit(1).
+		Find(&versions).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, nil
+	}
+	return versionModelToDomain(&versions[0]), nil
+}
+
+func (r *VersionRepository) CreateVersion(ctx *fiber.Ctx, version *domain.Version) error {
+	versionModel := models.Version{
+		ProjectID:   version.ProjectID,
+		Name:        version.Name,
+		Description: version.Description,
+		ReleaseDate: version.ReleaseDate,
+		IsActive:    true,
+	}
+
+	// The unique index on (project_id, LOWER(name)) catches concurrent creates
+	if err := r.db.Create(&versionModel).Error; err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrVersionExists
+		}
+		return err
+	}
+
+	version.ID = versionModel.ID
+	version.IsActive = versionModel.IsActive
+	version.CreatedAt = versionModel.CreatedAt
+	version.UpdatedAt = versionModel.UpdatedAt
+
+	return nil
+}
+
+func (r *VersionRepository) UpdateVersion(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateVersionRequest) (*domain.Version, error) {
+	updates := map[string]interface{}{}
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.ReleaseDate != nil {
+		updates["release_date"] = *req.ReleaseDate
+	}
+	if req.IsActive != nil {
+		updates["is_active"] = *req.IsActive
+	}
+
+	if len(updates) > 0 {
+		result := r.db.Model(&models.Version{}).Where("id = ? AND project_id = ?", id, projectID).Updates(updates)
+		if isUniqueViolation(result.Error) {
+			return nil, domain.ErrVersionExists
+		}
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil, gorm.ErrRecordNotFound
+		}
+	}
+
+	return r.GetVersionByID(ctx, projectID, id)
+}
+
+// DeleteVersion removes the version from every ticket and deletes it
+func (r *VersionRepository) DeleteVersion(ctx *fiber.Ctx, projectID uint, id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND project_id = ?", id, projectID).Delete(&models.Version{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Where("version_id = ?", id).Delete(&models.TicketVersion{}).Error
+	})
+}
+
+func (r *VersionRepository) ReleaseVersion(ctx *fiber.Ctx, projectID uint, id uint, nextID *uint, releaseDate time.Time, releasedBy uint) ([]uint, error) {
+	var moved []uint
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// The condition on is_released locks the version, a concurrent release
+		// waits and then updates nothing
+		now := time.Now()
+		result := tx.Model(&models.Version{}).
+			Where("id = ? AND project_id = ? AND is_released = ?", id, projectID, false).
+			Updates(map[string]interface{}{
+				"is_released":  true,
+				"release_date": releaseDate,
+				"released_at":  now,
+				"released_by":  releasedBy,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrVersionReleased
+		}
+
+		// Counted with the version locked, AddTicketVersion waits for the lock
+		// so no unresolved ticket can be added in between
+		if nextID == nil {
+			var unresolved int64
+			if err := r.unresolvedTickets(tx, id).Count(&unresolved).Error; err != nil {
+				return err
+			}
+			if unresolved > 0 {
+				return fmt.Errorf("%d %w", unresolved, domain.ErrUnresolvedTickets)
+			}
+			return nil
+		}
+
+		if err := r.unresolvedTickets(tx, id).Pluck("ticket_versions.ticket_id", &moved).Error; err != nil {
+			return err
+		}
+		if len(moved) == 0 {
+			return nil
+		}
+
+		// Tickets already fixed in the next version keep a single row
+		err := tx.Exec(`INSERT INTO ticket_versions (ticket_id, version_id, relation, created_at)
+SELECT ticket_id, ?, relation, ? FROM ticket_versions WHERE version_id = ? AND relation = ? AND ticket_id IN ?
+ON CONFLICT DO NOTHING`, *nextID, now, id, domain.VersionRelationFix, moved).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Where("version_id = ? AND relation = ? AND ticket_id IN ?", id, domain.VersionRelationFix, moved).
+			Delete(&models.TicketVersion{}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return moved, nil
+}
+
+func (r *VersionRepository) GetReleaseNotesTickets(ctx *fiber.Ctx, projectID uint, versionID uint) ([]*domain.Ticket, error) {
+	var tickets []models.Ticket
+	err := r.db.Preload("Type").
+		Joins("JOIN ticket_versions ON ticket_versions.ticket_id = tickets.id").
+		Joins("JOIN ticket_types ON ticket_types.id = tickets.type_id").
+		Where("tickets.project_id = ? AND ticket_versions.version_id = ? AND ticket_versions.relation = ?", projectID, versionID, domain.VersionRelationFix).
+		Where("tickets.resolved_at IS NOT NULL").
+		Order("ticket_types.position ASC, ticket_types.id ASC, tickets.ticket_number ASC").
+		Find(&tickets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Ticket, len(tickets))
+	for i, ticket := range tickets {
+		result[i] = ticketModelToDomain(&ticket)
+	}
+	return result, nil
+}
+
+func (r *VersionRepository) AddTicketVersion(ctx *fiber.Ctx, ticketID uint, versionID uint, relation string) (bool, error) {
+	var added bool
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// A release in progress holds the version, the ticket is added once it
+		// is done so the release never misses it
+		var version models.Version
+		if err := tx.Clauses(clause.Locking{Strength: "SHARE"}).Select("id").First(&version, versionID).Error; err != nil {
+			return err
+		}
+
+		result := tx.Exec("INSERT INTO ticket_versions (ticket_id, version_id, relation, created_at) VALUES (?, ?, ?, ?) ON CONFLICT DO NOTHING", ticketID, versionID, relation, time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		added = result.RowsAffected > 0
+		return nil
+	})
+	return added, err
+}
+
+func (r *VersionRepository) RemoveTicketVersion(ctx *fiber.Ctx, ticketID uint, versionID uint, relation string) error {
+	result := r.db.Where("ticket_id = ? AND version_id = ? AND relation = ?", ticketID, versionID, relation).Delete(&models.TicketVersion{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// unresolvedTickets selects the fix-version rows of the tickets of the version
+// that are not resolved yet.
+func (r *VersionRepository) unresolvedTickets(db *gorm.DB, versionID uint) *gorm.DB {
+	return db.Model(&models.TicketVersion{}).
+		Joins("JOIN tickets ON tickets.id = ticket_versions.ticket_id AND tickets.deleted_at IS NULL").
+		Where("ticket_versions.version_id = ? AND ticket_versions.relation = ?", versionID, domain.VersionRelationFix).
+		Where("tickets.resolved_at IS NULL")
+}
+
+func versionModelToDomain(model *models.Version) *domain.Version {
+	return &domain.Version{
+		ID:          model.ID,
+		ProjectID:   model.ProjectID,
+		Name:        model.Name,
+		Description: model.Description,
+		ReleaseDate: model.ReleaseDate,
+		IsReleased:  model.IsReleased,
+		IsActive:    model.IsActive,
+		ReleasedAt:  model.ReleasedAt,
+		ReleasedBy:  model.ReleasedBy,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+}
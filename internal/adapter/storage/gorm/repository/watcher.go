@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type WatcherRepository struct {
+	db *gorm.DB
+}
+
+func NewWatcherRepository(db *gorm.DB) *WatcherRepository {
+	return &WatcherRepository{db: db}
+}
+
+func (r *WatcherRepository) GetWatchers(ctx *fiber.Ctx, ticketID uint) ([]*domain.User, error) {
+	var users []models.User
+	err := r.db.Joins("JOIN ticket_watchers ON ticket_watchers.user_id = users.id").
+		Where("ticket_watchers.ticket_id = ?", ticketID).
+		Order("users.id asc").
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.User, len(users))
+	for i, user := range users {
+		result[i] = userModelToDomain(&user)
+	}
+	return result, nil
+}
+
+func (r *WatcherRepository) AddWatchers(ctx *fiber.Ctx, ticketID uint, userIDs []uint) ([]uint, error) {
+	added := []uint{}
+	for _, userID := range userIDs {
+		result := r.db.Exec("INSERT INTO ticket_watchers (ticket_id, user_id) VALUES (?, ?) ON CONFLICT DO NOTHING", ticketID, userID)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected > 0 {
+			added = append(added, userID)
+		}
+	}
+	return added, nil
+}
+
+func (r *WatcherRepository) RemoveWatcher(ctx *fiber.Ctx, ticketID uint, userID uint) error {
+	result := r.db.Exec("DELETE FROM ticket_watchers WHERE ticket_id = ? AND user_id = ?", ticketID, userID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *WatcherRepository) GetAutoWatchOptOuts(ctx *fiber.Ctx, userIDs []uint) ([]uint, error) {
+	var optOuts []uint
+	err := r.db.Model(&models.UserPreference{}).
+		Where("user_id IN ? AND key = ? AND context = ? AND value = ?", userIDs, domain.PreferenceAutoWatch, "", "false").
+		Pluck("user_id", &optOuts).Error
+	return optOuts, err
+}
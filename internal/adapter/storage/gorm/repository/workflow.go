@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"task-management/internal/adapter/storage/gorm/models"
+	"task-management/internal/core/domain"
+	"task-management/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type WorkflowRepository struct {
+	db *gorm.DB
+}
+
+func NewWorkflowRepository(db *gorm.DB) *WorkflowRepository {
+	return &WorkflowRepository{db: db}
+}
+
+func (r *WorkflowRepository) GetTransitions(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.WorkflowTransition, error) {
+	query := r.db.Where("project_id = ?", projectID)
+
+	total, page, limit, transitions, err := util.FindAll[models.WorkflowTransition](ctx, query, "ToStatus")
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	return total, page, limit, r.modelsToDomain(transitions), nil
+}
+
+// GetTransitionsForType returns the workflow of a ticket type. Transitions defined
+// for the type replace the project-wide ones (type_id IS NULL).
+func (r *WorkflowRepository) GetTransitionsForType(ctx *fiber.Ctx, projectID uint, typeID uint) ([]*domain.WorkflowTransition, error) {
+	var transitions []models.WorkflowTransition
+
+	err := r.db.Preload("ToStatus").Where("project_id = ? AND type_id = ?", projectID, typeID).Order("id asc").Find(&transitions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(transitions) == 0 {
+		err = r.db.Preload("ToStatus").Where("project_id = ? AND type_id IS NULL", projectID).Order("id asc").Find(&transitions).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r.modelsToDomain(transitions), nil
+}
+
+func (r *WorkflowRepository) CreateTransition(ctx *fiber.Ctx, transition *domain.WorkflowTransition) error {
+	transitionModel := models.WorkflowTransition{
+		ProjectID:          transition.ProjectID,
+		TypeID:             transition.TypeID,
+		FromStatusID:       transition.FromStatusID,
+		ToStatusID:         transition.ToStatusID,
+		Name:               transition.Name,
+		RequireResolution:  transition.RequireResolution,
+		RequireAssignee:    transition.RequireAssignee,
+		RequireManageTasks: transition.RequireManageTasks,
+	}
+
+	if err := r.db.Create(&transitionModel).Error; err != nil {
+		return err
+	}
+
+	transition.ID = transitionModel.ID
+	transition.CreatedAt = transitionModel.CreatedAt
+	transition.UpdatedAt = transitionModel.UpdatedAt
+
+	return nil
+}
+
+func (r *WorkflowRepository) DeleteTransition(ctx *fiber.Ctx, projectID uint, id uint) error {
+	result := r.db.Where("id = ? AND project_id = ?", id, projectID).Delete(&models.WorkflowTransition{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *WorkflowRepository) GetResolutions(ctx *fiber.Ctx) ([]*domain.Resolution, error) {
+	var resolutions []models.Resolution
+	if err := r.db.Order("id asc").Find(&resolutions).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Resolution, len(resolutions))
+	for i, resolution := range resolutions {
+		result[i] = r.resolutionModelToDomain(&resolution)
+	}
+	return result, nil
+}
+
+func (r *WorkflowRepository) GetResolutionByID(ctx *fiber.Ctx, id uint) (*domain.Resolution, error) {
+	var resolution models.Resolution
+	if err := r.db.First(&resolution, id).Error; err != nil {
+		return nil, err
+	}
+	return r.resolutionModelToDomain(&resolution), nil
+}
+
+func (r *WorkflowRepository) GetDefaultResolution(ctx *fiber.Ctx) (*domain.Resolution, error) {
+	var resolution models.Resolution
+	if err := r.db.Where("is_default = ?", true).First(&resolution).Error; err != nil {
+		return nil, err
+	}
+	return r.resolutionModelToDomain(&resolution), nil
+}
+
+func (r *WorkflowRepository) modelToDomain(model *models.WorkflowTransition) *domain.WorkflowTransition {
+	transition := &domain.WorkflowTransition{
+		ID:                 model.ID,
+		ProjectID:          model.ProjectID,
+		TypeID:             model.TypeID,
+		FromStatusID:       model.FromStatusID,
+		ToStatusID:         model.ToStatusID,
+		Name:               model.Name,
+		RequireResolution:  model.RequireResolution,
+		RequireAssignee:    model.RequireAssignee,
+		RequireManageTasks: model.RequireManageTasks,
+		CreatedAt:          model.CreatedAt,
+		UpdatedAt:          model.UpdatedAt,
+	}
+	if model.ToStatus.ID != 0 {
+		transition.ToStatus = ticketStatusModelToDomain(&model.ToStatus)
+	}
+	return transition
+}
+
+func (r *WorkflowRepository) modelsToDomain(models []models.WorkflowTransition) []*domain.WorkflowTransition {
+	transitions := make([]*domain.WorkflowTransition, len(models))
+	for i, model := range models {
+		transitions[i] = r.modelToDomain(&model)
+	}
+	return transitions
+}
+
+func (r *WorkflowRepository) resolutionModelToDomain(model *models.Resolution) *domain.Resolution {
+	return &domain.Resolution{
+		ID:          model.ID,
+		Name:        model.Name,
+		Description: model.Description,
+		IsDefault:   model.IsDefault,
+	}
+}
@@ -5,4 +5,8 @@ var Views = map[string]string{
 select o.id as organization_id, o.name as organization_name, o.slug, om.user_id, omr.* from organization_members om 
 left join organizations o on om.organization_id = o.id 
 left join organization_member_roles omr on om.role_id = omr.id `,
+	"vw_project_member_roles": `create view vw_project_member_roles as
+select pm.project_id, pm.user_id, pmr.* from project_members pm 
+left join project_member_roles pmr on pm.role_id = pmr.id 
+where pm.deleted_at is null and pm.status_id = 1`,
 }
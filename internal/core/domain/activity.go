@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// Ticket activity actions
+const (
+	ActivityActionCreated      = "created"
+	ActivityActionUpdated      = "updated"
+	ActivityActionTransitioned = "transitioned"
+	ActivityActionDeleted      = "deleted"
+	ActivityActionAdded        = "added"   // a value was added to a list field (labels, watchers, ...)
+	ActivityActionRemoved      = "removed" // a value was removed from a list field
+	ActivityActionAutoAssigned = "auto_assigned"
+)
+
+// TicketActivity is one entry of the ticket audit log. Field is empty for
+// created and deleted entries. Values are stored as text, references as their ID.
+type TicketActivity struct {
+	ID        uint      `json:"id"`
+	TicketID  uint      `json:"ticket_id"`
+	ProjectID uint      `json:"project_id"`
+	UserID    uint      `json:"user_id"`
+	Action    string    `json:"action"`
+	Field     string    `json:"field"`
+	OldValue  *string   `json:"old_value"`
+	NewValue  *string   `json:"new_value"`
+	User      *User     `json:"user,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
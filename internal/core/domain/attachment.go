@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	ErrFileNotFound     = errors.New("file not found in storage")
+	ErrFileTooLarge     = errors.New("file exceeds the maximum upload size")
+	ErrInvalidSignature = errors.New("download link is invalid or has expired")
+	ErrNoThumbnail      = errors.New("attachment has no thumbnail of this size")
+)
+
+// Thumbnail sizes, the longest side of the image is scaled down to fit
+const (
+	ThumbnailSizeSmall  = "small"
+	ThumbnailSizeMedium = "medium"
+)
+
+type Attachment struct {
+	ID         uint      `json:"id"`
+	TicketID   uint      `json:"ticket_id"`
+	Filename   string    `json:"filename"`
+	FilePath   string    `json:"-"` // storage key
+	FileSize   int64     `json:"file_size"`
+	MimeType   string    `json:"mime_type"`
+	UploadedBy uint      `json:"uploaded_by"`
+	Uploader   *User     `json:"uploader,omitempty"`
+	Width      *int      `json:"width,omitempty"`
+	Height     *int      `json:"height,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	Thumbnails []*AttachmentThumbnail `json:"thumbnails"`
+}
+
+// AttachmentThumbnail is a downscaled copy of an image attachment, served by
+// the thumbnail endpoint.
+type AttachmentThumbnail struct {
+	Size     string `json:"size"`
+	FilePath string `json:"-"` // storage key
+	FileSize int64  `json:"file_size"`
+	MimeType string `json:"mime_type"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+}
+
+// Thumbnail returns the thumbnail of the given size, nil if there is none.
+func (a *Attachment) Thumbnail(size string) *AttachmentThumbnail {
+	for _, thumbnail := range a.Thumbnails {
+		if thumbnail.Size == size {
+			return thumbnail
+		}
+	}
+	return nil
+}
+
+// UploadAttachmentRequest is built by the handler from a multipart file.
+type UploadAttachmentRequest struct {
+	Filename string
+	Size     int64
+	Content  io.Reader
+}
+
+// SignedURL is a download link that works without the Authorization header
+// until it expires or the attachment is deleted.
+type SignedURL struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Board swimlanes group the tickets of every column by one of these fields
+const (
+	SwimlaneNone     = "none"
+	SwimlaneAssignee = "assignee"
+	SwimlanePriority = "priority"
+	SwimlaneEpic     = "epic"
+)
+
+// WIP modes decide what happens when a transition goes over a column limit
+const (
+	WIPModeWarn    = "warn"
+	WIPModeEnforce = "enforce"
+)
+
+var ErrWIPLimitReached = errors.New("the work in progress limit of the column is reached")
+
+type Board struct {
+	ID         uint           `json:"id"`
+	ProjectID  uint           `json:"project_id"`
+	Name       string         `json:"name"`
+	SwimlaneBy string         `json:"swimlane_by"`
+	WIPMode    string         `json:"wip_mode"`
+	Columns    []*BoardColumn `json:"columns"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+type BoardColumn struct {
+	ID        uint            `json:"id"`
+	Name      string          `json:"name"`
+	Position  int             `json:"position"`
+	WIPLimit  *int            `json:"wip_limit"`
+	StatusIDs []uint          `json:"status_ids"`
+	Statuses  []*TicketStatus `json:"statuses,omitempty"`
+}
+
+// BoardColumnRequest maps a column to project statuses, a status can only be
+// in one column of the board.
+type BoardColumnRequest struct {
+	Name      string `json:"name" validate:"required,min=1,max=100"`
+	StatusIDs []uint `json:"status_ids" validate:"required,min=1,dive,min=1"`
+	WIPLimit  *int   `json:"wip_limit" validate:"omitempty,min=1"`
+}
+
+// CreateBoardRequest columns are displayed in the order they are sent
+type CreateBoardRequest struct {
+	Name       string               `json:"name" validate:"required,min=1,max=100"`
+	SwimlaneBy string               `json:"swimlane_by" validate:"omitempty,oneof=none assignee priority epic"`
+	WIPMode    string               `json:"wip_mode" validate:"omitempty,oneof=warn enforce"`
+	Columns    []BoardColumnRequest `json:"columns" validate:"required,min=1,dive"`
+}
+
+// UpdateBoardRequest only changes the fields that are present in the body,
+// Columns replaces all the columns of the board.
+type UpdateBoardRequest struct {
+	Name       *string              `json:"name" validate:"omitempty,min=1,max=100"`
+	SwimlaneBy *string              `json:"swimlane_by" validate:"omitempty,oneof=none assignee priority epic"`
+	WIPMode    *string              `json:"wip_mode" validate:"omitempty,oneof=warn enforce"`
+	Columns    []BoardColumnRequest `json:"columns" validate:"omitempty,min=1,dive"`
+}
+
+// BoardView is a board with its tickets grouped by swimlane and column
+type BoardView struct {
+	Board     *Board            `json:"board"`
+	Columns   []*BoardColumnWIP `json:"columns"`
+	Swimlanes []*BoardSwimlane  `json:"swimlanes"`
+}
+
+// BoardColumnWIP is the number of tickets of a column across all swimlanes
+type BoardColumnWIP struct {
+	ColumnID    uint `json:"column_id"`
+	TicketCount int  `json:"ticket_count"`
+	WIPLimit    *int `json:"wip_limit"`
+	OverLimit   bool `json:"over_limit"`
+}
+
+type BoardSwimlane struct {
+	Key     string       `json:"key"`
+	Name    string       `json:"name"`
+	Columns []*BoardCell `json:"columns"`
+}
+
+// BoardCell holds the tickets of a column inside a swimlane, ordered by rank
+type BoardCell struct {
+	ColumnID uint      `json:"column_id"`
+	Tickets  []*Ticket `json:"tickets"`
+}
+
+// ColumnWIP is a limited column a ticket would enter with its current load
+type ColumnWIP struct {
+	BoardID     uint   `json:"board_id"`
+	BoardName   string `json:"board_name"`
+	WIPMode     string `json:"wip_mode"`
+	ColumnID    uint   `json:"column_id"`
+	ColumnName  string `json:"column_name"`
+	WIPLimit    int    `json:"wip_limit"`
+	TicketCount int    `json:"ticket_count"`
+}
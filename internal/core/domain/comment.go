@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// TicketComment is returned as a tombstone once deleted: IsDeleted is set and
+// the content is left empty.
+type TicketComment struct {
+	ID        uint       `json:"id"`
+	TicketID  uint       `json:"ticket_id"`
+	UserID    uint       `json:"user_id"`
+	Content   string     `json:"content"`
+	IsEdited  bool       `json:"is_edited"`
+	EditedAt  *time.Time `json:"edited_at"`
+	IsDeleted bool       `json:"is_deleted"`
+	DeletedAt *time.Time `json:"deleted_at"`
+	DeletedBy *uint      `json:"deleted_by"`
+	User      *User      `json:"user,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type TicketCommentRevision struct {
+	ID        uint      `json:"id"`
+	CommentID uint      `json:"comment_id"`
+	Content   string    `json:"content"`
+	EditedBy  uint      `json:"edited_by"`
+	User      *User     `json:"user,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CreateCommentRequest struct {
+	Content string `json:"content" validate:"required,min=1,max=10000"`
+}
+
+type UpdateCommentRequest struct {
+	Content string `json:"content" validate:"required,min=1,max=10000"`
+}
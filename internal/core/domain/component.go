@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+type Component struct {
+	ID          uint      `json:"id"`
+	ProjectID   uint      `json:"project_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	LeadID      *uint     `json:"lead_id"`
+	Lead        *User     `json:"lead,omitempty"`
+	IsActive    bool      `json:"is_active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type CreateComponentRequest struct {
+	Name        string `json:"name" validate:"required,min=1,max=100"`
+	Description string `json:"description"`
+	LeadID      *uint  `json:"lead_id" validate:"omitempty,min=1"`
+}
+
+// UpdateComponentRequest only changes the fields that are present in the body.
+// LeadID can be cleared by sending 0.
+type UpdateComponentRequest struct {
+	Name        *string `json:"name" validate:"omitempty,min=1,max=100"`
+	Description *string `json:"description"`
+	LeadID      *uint   `json:"lead_id"`
+	IsActive    *bool   `json:"is_active"`
+}
@@ -0,0 +1,8 @@
+package domain
+
+import "errors"
+
+var (
+	ErrForbidden             = errors.New("you don't have permission to perform this action")
+	ErrUserNotInOrganization = errors.New("user is not a member of this organization")
+)
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrLabelExists      = errors.New("a label with this name already exists in the project")
+	ErrLabelMergeTarget = errors.New("target label does not belong to this project")
+)
+
+type Label struct {
+	ID          uint      `json:"id"`
+	ProjectID   uint      `json:"project_id"`
+	Name        string    `json:"name"`
+	Color       string    `json:"color"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type CreateLabelRequest struct {
+	Name        string `json:"name" validate:"required,min=1,max=100"`
+	Color       string `json:"color" validate:"omitempty,hexcolor,len=7"`
+	Description string `json:"description" validate:"max=500"`
+}
+
+// UpdateLabelRequest only changes the fields that are present in the body
+type UpdateLabelRequest struct {
+	Name        *string `json:"name" validate:"omitempty,min=1,max=100"`
+	Color       *string `json:"color" validate:"omitempty,hexcolor,len=7"`
+	Description *string `json:"description" validate:"omitempty,max=500"`
+}
+
+// MergeLabelsRequest moves every ticket of a label to the target label and
+// deletes the merged label.
+type MergeLabelsRequest struct {
+	TargetID uint `json:"target_id" validate:"required,min=1"`
+}
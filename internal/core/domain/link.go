@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Link types are stored from the source ticket point of view
+const (
+	LinkTypeBlocks     = "blocks"
+	LinkTypeRelates    = "relates"
+	LinkTypeDuplicates = "duplicates"
+	LinkTypeClones     = "clones"
+)
+
+// Inward link types are accepted when creating a link and stored as the
+// outward type with the two tickets swapped
+const (
+	LinkTypeIsBlockedBy    = "is_blocked_by"
+	LinkTypeIsDuplicatedBy = "is_duplicated_by"
+	LinkTypeIsClonedBy     = "is_cloned_by"
+)
+
+// Directions of a link seen from one of its tickets
+const (
+	LinkDirectionOutward = "outward"
+	LinkDirectionInward  = "inward"
+)
+
+var (
+	ErrLinkExists = errors.New("tickets are already linked")
+	ErrLinkCycle  = errors.New("the link would create a cycle of blocking tickets")
+)
+
+// linkDescriptions are the outward and inward descriptions of every link type
+var linkDescriptions = map[string][2]string{
+	LinkTypeBlocks:     {"blocks", "is blocked by"},
+	LinkTypeRelates:    {"relates to", "relates to"},
+	LinkTypeDuplicates: {"duplicates", "is duplicated by"},
+	LinkTypeClones:     {"clones", "is cloned by"},
+}
+
+// inwardLinkTypes maps the inward link types to their outward type
+var inwardLinkTypes = map[string]string{
+	LinkTypeIsBlockedBy:    LinkTypeBlocks,
+	LinkTypeIsDuplicatedBy: LinkTypeDuplicates,
+	LinkTypeIsClonedBy:     LinkTypeClones,
+}
+
+// TicketLink is a link seen from one of its tickets, Ticket is the other one
+type TicketLink struct {
+	ID             uint      `json:"id"`
+	SourceTicketID uint      `json:"source_ticket_id"`
+	TargetTicketID uint      `json:"target_ticket_id"`
+	LinkType       string    `json:"link_type"`
+	Direction      string    `json:"direction"`
+	Description    string    `json:"description"`
+	Ticket         *Ticket   `json:"ticket,omitempty"`
+	CreatedBy      uint      `json:"created_by"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// CreateTicketLinkRequest links the ticket to the ticket with TargetKey, which
+// can be in another project of the organization
+type CreateTicketLinkRequest struct {
+	TargetKey string `json:"target_key" validate:"required,max=30"`
+	LinkType  string `json:"link_type" validate:"required,oneof=blocks is_blocked_by relates duplicates is_duplicated_by clones is_cloned_by"`
+}
+
+// NormalizeLinkType returns the stored link type and whether the tickets must
+// be swapped for it
+func NormalizeLinkType(linkType string) (string, bool) {
+	if outward, ok := inwardLinkTypes[linkType]; ok {
+		return outward, true
+	}
+	return linkType, false
+}
+
+// LinkDescription describes the link from its source (outward) or target ticket
+func LinkDescription(linkType string, direction string) string {
+	descriptions, ok := linkDescriptions[linkType]
+	if !ok {
+		return linkType
+	}
+	if direction == LinkDirectionInward {
+		return descriptions[1]
+	}
+	return descriptions[0]
+}
@@ -0,0 +1,51 @@
+package domain
+
+import "testing"
+
+func TestNormalizeLinkType(t *testing.T) {
+	tests := []struct {
+		linkType string
+		want     string
+		swapped  bool
+	}{
+		{linkType: LinkTypeBlocks, want: LinkTypeBlocks},
+		{linkType: LinkTypeRelates, want: LinkTypeRelates},
+		{linkType: LinkTypeDuplicates, want: LinkTypeDuplicates},
+		{linkType: LinkTypeClones, want: LinkTypeClones},
+		{linkType: LinkTypeIsBlockedBy, want: LinkTypeBlocks, swapped: true},
+		{linkType: LinkTypeIsDuplicatedBy, want: LinkTypeDuplicates, swapped: true},
+		{linkType: LinkTypeIsClonedBy, want: LinkTypeClones, swapped: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.linkType, func(t *testing.T) {
+			got, swapped := NormalizeLinkType(tt.linkType)
+			if got != tt.want || swapped != tt.swapped {
+				t.Fatalf("NormalizeLinkType(%q) = %q, %v, want %q, %v", tt.linkType, got, swapped, tt.want, tt.swapped)
+			}
+		})
+	}
+}
+
+func TestLinkDescription(t *testing.T) {
+	tests := []struct {
+		linkType  string
+		direction string
+		want      string
+	}{
+		{linkType: LinkTypeBlocks, direction: LinkDirectionOutward, want: "blocks"},
+		{linkType: LinkTypeBlocks, direction: LinkDirectionInward, want: "is blocked by"},
+		{linkType: LinkTypeRelates, direction: LinkDirectionInward, want: "relates to"},
+		{linkType: LinkTypeDuplicates, direction: LinkDirectionInward, want: "is duplicated by"},
+		{linkType: LinkTypeClones, direction: LinkDirectionOutward, want: "clones"},
+		{linkType: "unknown", direction: LinkDirectionInward, want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.linkType+" "+tt.direction, func(t *testing.T) {
+			if got := LinkDescription(tt.linkType, tt.direction); got != tt.want {
+				t.Fatalf("LinkDescription(%q, %q) = %q, want %q", tt.linkType, tt.direction, got, tt.want)
+			}
+		})
+	}
+}
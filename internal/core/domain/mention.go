@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+type TicketMention struct {
+	ID          uint      `json:"id"`
+	TicketID    uint      `json:"ticket_id"`
+	CommentID   *uint     `json:"comment_id"` // nil when mentioned in the description
+	UserID      uint      `json:"user_id"`
+	MentionedBy uint      `json:"mentioned_by"`
+	User        *User     `json:"user,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
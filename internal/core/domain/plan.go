@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrPlanLimitReached    = errors.New("plan limit reached")
+	ErrFeatureNotAvailable = errors.New("feature not available on plan")
+)
+
+// Plan types of an organization
+const (
+	PlanFree       = "free"
+	PlanPro        = "pro"
+	PlanEnterprise = "enterprise"
+)
+
+// Limits of a plan, reported in EntitlementError.Limit
+const (
+	LimitProjects       = "projects"
+	LimitProjectMembers = "project_members"
+	LimitStorage        = "storage"
+)
+
+// Features that are only available on some plans
+const (
+	FeatureCustomWorkflows = "custom_workflows"
+	FeatureAutomation      = "automation"
+	FeatureReports         = "reports"
+)
+
+// Plan describes what an organization may use. Nil limits are unlimited.
+type Plan struct {
+	PlanType          string   `json:"plan_type"`
+	MaxProjects       *int64   `json:"max_projects"` // archived projects do not count
+	MaxProjectMembers *int64   `json:"max_project_members"`
+	StorageQuota      *int64   `json:"storage_quota"` // bytes
+	Features          []string `json:"features"`
+}
+
+func (p *Plan) HasFeature(feature string) bool {
+	for _, f := range p.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+var plans = map[string]*Plan{
+	PlanFree: {
+		PlanType:          PlanFree,
+		MaxProjects:       planLimit(3),
+		MaxProjectMembers: planLimit(10),
+		StorageQuota:      planLimit(1 << 30), // 1 GiB
+		Features:          []string{},
+	},
+	PlanPro: {
+		PlanType:          PlanPro,
+		MaxProjects:       planLimit(50),
+		MaxProjectMembers: planLimit(100),
+		StorageQuota:      planLimit(100 << 30), // 100 GiB
+		Features:          []string{FeatureCustomWorkflows, FeatureAutomation, FeatureReports},
+	},
+	PlanEnterprise: {
+		PlanType: PlanEnterprise,
+		Features: []string{FeatureCustomWorkflows, FeatureAutomation, FeatureReports},
+	},
+}
+
+// GetPlan returns the plan of the given type. Unknown plan types get the free
+// plan so a typo never unlocks everything.
+func GetPlan(planType string) *Plan {
+	if plan, ok := plans[planType]; ok {
+		return plan
+	}
+	return plans[PlanFree]
+}
+
+// StorageQuota returns the attachment storage of a plan in bytes, nil when it
+// is unlimited.
+func StorageQuota(planType string) *int64 {
+	return GetPlan(planType).StorageQuota
+}
+
+func planLimit(n int64) *int64 {
+	return &n
+}
+
+// EntitlementError is returned when the plan of the organization does not
+// allow an action. It is sent as the data of the error response so the
+// frontend can offer an upgrade.
+type EntitlementError struct {
+	PlanType string `json:"plan_type"`
+	Limit    string `json:"limit,omitempty"`
+	Max      *int64 `json:"max,omitempty"`
+	Feature  string `json:"feature,omitempty"`
+}
+
+func (e *EntitlementError) Error() string {
+	if e.Feature != "" {
+		return fmt.Sprintf("%s is not available on the %s plan", e.Feature, e.PlanType)
+	}
+	if e.Limit == LimitStorage {
+		return ErrStorageQuotaExceeded.Error()
+	}
+	return fmt.Sprintf("the %s plan allows at most %d %s", e.PlanType, *e.Max, e.Limit)
+}
+
+func (e *EntitlementError) Unwrap() error {
+	switch {
+	case e.Feature != "":
+		return ErrFeatureNotAvailable
+	case e.Limit == LimitStorage:
+		return ErrStorageQuotaExceeded
+	default:
+		return ErrPlanLimitReached
+	}
+}
@@ -0,0 +1,106 @@
+package domain
+
+import "time"
+
+// Project status IDs seeded by scripts/migration.go
+const (
+	ProjectStatusActive   uint = 1
+	ProjectStatusArchived uint = 6
+)
+
+// Auto-assignment modes of unassigned tickets created in a project
+const (
+	AutoAssignNone          = "none"
+	AutoAssignComponentLead = "component_lead"
+	AutoAssignProjectOwner  = "project_owner"
+)
+
+// Default project member role given to the creator of a project
+const ProjectRoleManager uint = 1
+
+type Project struct {
+	ID             uint      `json:"id"`
+	OrganizationID uint      `json:"organization_id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	Key            string    `json:"key"`
+	OwnerID        uint      `json:"owner_id"`
+	StatusID       uint      `json:"status_id"`
+	AutoAssignMode string    `json:"auto_assign_mode"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type CreateProjectRequest struct {
+	Name        string `json:"name" validate:"required,min=3,max=255"`
+	Description string `json:"description" validate:"omitempty,max=2000"`
+	Key         string `json:"key" validate:"required,min=2,max=10,alphanum,uppercase"`
+}
+
+type UpdateProjectRequest struct {
+	Name        string `json:"name" validate:"omitempty,min=3,max=255"`
+	Description string `json:"description" validate:"omitempty,max=2000"`
+	OwnerID     uint   `json:"owner_id" validate:"omitempty,min=1"`
+	StatusID    uint   `json:"status_id" validate:"omitempty,min=1,max=6"`
+	// AutoAssignMode decides who unassigned tickets are given to on creation
+	AutoAssignMode string `json:"auto_assign_mode" validate:"omitempty,oneof=none component_lead project_owner"`
+}
+
+type ProjectMember struct {
+	ID        uint       `json:"id"`
+	ProjectID uint       `json:"project_id"`
+	UserID    uint       `json:"user_id"`
+	RoleID    uint       `json:"role_id"`
+	StatusID  uint       `json:"status_id"`
+	InvitedAt *time.Time `json:"invited_at"`
+	JoinedAt  *time.Time `json:"joined_at"`
+	InvitedBy *uint      `json:"invited_by"`
+	User      *User      `json:"user,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type AddProjectMemberRequest struct {
+	UserID uint `json:"user_id" validate:"required,min=1"`
+	RoleID uint `json:"role_id" validate:"required,min=1,max=6"`
+}
+
+type ProjectMemberRole struct {
+	ID                  uint   `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	IsDefault           bool   `json:"is_default"`
+	CanManageProject    bool   `json:"can_manage_project"`
+	CanManageMembers    bool   `json:"can_manage_members"`
+	CanCreateTasks      bool   `json:"can_create_tasks"`
+	CanManageTasks      bool   `json:"can_manage_tasks"`
+	CanDeleteTasks      bool   `json:"can_delete_tasks"`
+	CanViewAllTasks     bool   `json:"can_view_all_tasks"`
+	CanManageComponents bool   `json:"can_manage_components"`
+	CanManageVersions   bool   `json:"can_manage_versions"`
+}
+
+// ProjectManagerRole is granted to organization members who can manage every
+// project (CanManageProjects) but are not members of the project itself.
+func ProjectManagerRole() *ProjectMemberRole {
+	return &ProjectMemberRole{
+		Name:                "Organization Manager",
+		CanManageProject:    true,
+		CanManageMembers:    true,
+		CanCreateTasks:      true,
+		CanManageTasks:      true,
+		CanDeleteTasks:      true,
+		CanViewAllTasks:     true,
+		CanManageComponents: true,
+		CanManageVersions:   true,
+	}
+}
+
+// ProjectViewerRole is granted to organization members who can view every
+// project (CanViewAllProjects) but are not members of the project itself.
+func ProjectViewerRole() *ProjectMemberRole {
+	return &ProjectMemberRole{
+		Name:            "Organization Viewer",
+		CanViewAllTasks: true,
+	}
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Sprint states, a sprint goes from planned to active to completed
+const (
+	SprintStatePlanned   = "planned"
+	SprintStateActive    = "active"
+	SprintStateCompleted = "completed"
+)
+
+var (
+	ErrSprintAlreadyActive = errors.New("the project already has an active sprint")
+	ErrSprintNotPlanned    = errors.New("only planned sprints can be started")
+	ErrSprintNotActive     = errors.New("only active sprints can be completed")
+	ErrSprintCompleted     = errors.New("sprint is completed")
+)
+
+type Sprint struct {
+	ID              uint       `json:"id"`
+	ProjectID       uint       `json:"project_id"`
+	Name            string     `json:"name"`
+	Goal            string     `json:"goal"`
+	State           string     `json:"state"`
+	StartDate       *time.Time `json:"start_date"`
+	EndDate         *time.Time `json:"end_date"`
+	StartedAt       *time.Time `json:"started_at"`
+	CompletedAt     *time.Time `json:"completed_at"`
+	CommittedPoints int        `json:"committed_points"`
+	CompletedPoints int        `json:"completed_points"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+type CreateSprintRequest struct {
+	Name      string     `json:"name" validate:"required,min=1,max=100"`
+	Goal      string     `json:"goal"`
+	StartDate *time.Time `json:"start_date"`
+	EndDate   *time.Time `json:"end_date"`
+}
+
+// UpdateSprintRequest only changes the fields that are present in the body
+type UpdateSprintRequest struct {
+	Name      *string    `json:"name" validate:"omitempty,min=1,max=100"`
+	Goal      *string    `json:"goal"`
+	StartDate *time.Time `json:"start_date"`
+	EndDate   *time.Time `json:"end_date"`
+}
+
+// StartSprintRequest overrides the planned goal and dates, StartDate defaults
+// to now and EndDate to the planned end date.
+type StartSprintRequest struct {
+	Goal      *string    `json:"goal"`
+	StartDate *time.Time `json:"start_date"`
+	EndDate   *time.Time `json:"end_date"`
+}
+
+// CompleteSprintRequest moves the unresolved tickets to MoveToSprintID, or to
+// the backlog when it is not set.
+type CompleteSprintRequest struct {
+	MoveToSprintID *uint `json:"move_to_sprint_id" validate:"omitempty,min=1"`
+}
+
+type SprintTicketsRequest struct {
+	TicketIDs []uint `json:"ticket_ids" validate:"required,min=1,dive,min=1"`
+}
+
+// SprintCompletion is the completed sprint with the tickets carried over to
+// MovedTo, nil when they went back to the backlog.
+type SprintCompletion struct {
+	Sprint               *Sprint `json:"sprint"`
+	MovedTo              *Sprint `json:"moved_to,omitempty"`
+	CarriedOverTicketIDs []uint  `json:"carried_over_ticket_ids"`
+}
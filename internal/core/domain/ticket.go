@@ -0,0 +1,149 @@
+package domain
+
+import "time"
+
+// Ticket type ID used when a ticket is created without one (seeded as "Task")
+const DefaultTicketTypeID uint = 1
+
+// Priority ID used when a ticket is created without one (seeded as "Medium")
+const DefaultPriorityID uint = 3
+
+// Ticket type hierarchy levels, the parent of a ticket is one level above it
+const (
+	HierarchyLevelSubtask  = 1
+	HierarchyLevelStandard = 2
+	HierarchyLevelEpic     = 3
+)
+
+type Ticket struct {
+	ID              uint          `json:"id"`
+	ProjectID       uint          `json:"project_id"`
+	Title           string        `json:"title"`
+	Description     string        `json:"description"`
+	TicketKey       string        `json:"ticket_key"`
+	TicketNumber    uint          `json:"ticket_number"`
+	TypeID          uint          `json:"type_id"`
+	StatusID        uint          `json:"status_id"`
+	PriorityID      uint          `json:"priority_id"`
+	AssigneeID      *uint         `json:"assignee_id"`
+	ReporterID      uint          `json:"reporter_id"`
+	ParentID        *uint         `json:"parent_id"`
+	EstimatedHours  *float64      `json:"estimated_hours"`
+	ActualHours     *float64      `json:"actual_hours"`
+	DueDate         *time.Time    `json:"due_date"`
+	StoryPoints     *int          `json:"story_points"`
+	SprintID        *uint         `json:"sprint_id"`
+	Rank            string        `json:"rank"`
+	ResolutionID    *uint         `json:"resolution_id"`
+	ResolvedAt      *time.Time    `json:"resolved_at"`
+	ResolvedBy      *uint         `json:"resolved_by"`
+	Type            *TicketType   `json:"type,omitempty"`
+	Status          *TicketStatus `json:"status,omitempty"`
+	Priority        *Priority     `json:"priority,omitempty"`
+	Assignee        *User         `json:"assignee,omitempty"`
+	Reporter        *User         `json:"reporter,omitempty"`
+	Labels          []*Label      `json:"labels,omitempty"`
+	Components      []*Component  `json:"components,omitempty"`
+	FixVersions     []*Version    `json:"fix_versions,omitempty"`
+	AffectsVersions []*Version    `json:"affects_versions,omitempty"`
+	Rollup          *TicketRollup `json:"rollup,omitempty"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
+}
+
+// TicketRollup sums the descendants of a ticket, it is only set on tickets
+// that have children
+type TicketRollup struct {
+	Descendants int `json:"descendants"`
+	Resolved    int `json:"resolved"`
+	// StoryPoints and EstimatedHours add up the descendants without children,
+	// the estimate of a parent being split among its children
+	StoryPoints    int     `json:"story_points"`
+	EstimatedHours float64 `json:"estimated_hours"`
+	// ActualHours adds up the hours logged on every descendant
+	ActualHours float64 `json:"actual_hours"`
+	// PercentDone is the share of resolved descendants
+	PercentDone int `json:"percent_done"`
+}
+
+type CreateTicketRequest struct {
+	Title          string     `json:"title" validate:"required,min=1,max=500"`
+	Description    string     `json:"description"`
+	TypeID         uint       `json:"type_id" validate:"omitempty,min=1"`
+	PriorityID     uint       `json:"priority_id" validate:"omitempty,min=1"`
+	AssigneeID     *uint      `json:"assignee_id" validate:"omitempty,min=1"`
+	ParentID       *uint      `json:"parent_id" validate:"omitempty,min=1"`
+	EstimatedHours *float64   `json:"estimated_hours" validate:"omitempty,min=0"`
+	DueDate        *time.Time `json:"due_date"`
+	StoryPoints    *int       `json:"story_points" validate:"omitempty,min=0"`
+	// ComponentIDs are the project components of the ticket, the first one
+	// decides the assignee in the component_lead auto-assign mode
+	ComponentIDs []uint `json:"component_ids" validate:"omitempty,dive,min=1"`
+}
+
+// RankTicketRequest places a ticket right before BeforeID and/or right after
+// AfterID, at least one of them is required.
+type RankTicketRequest struct {
+	BeforeID *uint `json:"before_id" validate:"omitempty,min=1"`
+	AfterID  *uint `json:"after_id" validate:"omitempty,min=1"`
+}
+
+// UpdateTicketRequest only changes the fields that are present in the body.
+// AssigneeID and ParentID can be cleared by sending 0. The status is changed
+// through a workflow transition instead.
+type UpdateTicketRequest struct {
+	Title          *string    `json:"title" validate:"omitempty,min=1,max=500"`
+	Description    *string    `json:"description"`
+	TypeID         *uint      `json:"type_id" validate:"omitempty,min=1"`
+	PriorityID     *uint      `json:"priority_id" validate:"omitempty,min=1"`
+	AssigneeID     *uint      `json:"assignee_id"`
+	ParentID       *uint      `json:"parent_id"`
+	EstimatedHours *float64   `json:"estimated_hours" validate:"omitempty,min=0"`
+	DueDate        *time.Time `json:"due_date"`
+	StoryPoints    *int       `json:"story_points" validate:"omitempty,min=0"`
+}
+
+type TicketStatus struct {
+	ID          uint   `json:"id"`
+	ProjectID   uint   `json:"project_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Color       string `json:"color"`
+	Position    int    `json:"position"`
+	IsDefault   bool   `json:"is_default"`
+	IsActive    bool   `json:"is_active"`
+	IsClosed    bool   `json:"is_closed"`
+	IsResolved  bool   `json:"is_resolved"`
+}
+
+type TicketStatusHistory struct {
+	ID           uint          `json:"id"`
+	TicketID     uint          `json:"ticket_id"`
+	ProjectID    uint          `json:"project_id"`
+	FromStatusID *uint         `json:"from_status_id"`
+	ToStatusID   uint          `json:"to_status_id"`
+	ChangedBy    uint          `json:"changed_by"`
+	ChangedAt    time.Time     `json:"changed_at"`
+	FromStatus   *TicketStatus `json:"from_status,omitempty"`
+	ToStatus     *TicketStatus `json:"to_status,omitempty"`
+}
+
+type TicketType struct {
+	ID             uint   `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Icon           string `json:"icon"`
+	Color          string `json:"color"`
+	IsActive       bool   `json:"is_active"`
+	Position       int    `json:"position"`
+	HierarchyLevel int    `json:"hierarchy_level"`
+}
+
+type Priority struct {
+	ID          uint   `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Color       string `json:"color"`
+	Level       int    `json:"level"`
+	IsActive    bool   `json:"is_active"`
+}
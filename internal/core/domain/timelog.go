@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// MaxTimeLogHours is the most time a single log can hold
+const MaxTimeLogHours = 24
+
+var (
+	ErrTimerRunning    = errors.New("a timer is already running, stop it first")
+	ErrTimerNotRunning = errors.New("no timer is running on this ticket")
+)
+
+type TimeLog struct {
+	ID          uint      `json:"id"`
+	TicketID    uint      `json:"ticket_id"`
+	UserID      uint      `json:"user_id"`
+	Hours       float64   `json:"hours"`
+	Description string    `json:"description"`
+	LoggedDate  time.Time `json:"logged_date"`
+	User        *User     `json:"user,omitempty"`
+	Ticket      *Ticket   `json:"ticket,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateTimeLogRequest logs work on the ticket, LoggedDate defaults to now
+type CreateTimeLogRequest struct {
+	Hours       float64    `json:"hours" validate:"required,gt=0,lte=24"`
+	Description string     `json:"description"`
+	LoggedDate  *time.Time `json:"logged_date"`
+}
+
+// UpdateTimeLogRequest only changes the fields that are present in the body
+type UpdateTimeLogRequest struct {
+	Hours       *float64   `json:"hours" validate:"omitempty,gt=0,lte=24"`
+	Description *string    `json:"description"`
+	LoggedDate  *time.Time `json:"logged_date"`
+}
+
+type Timer struct {
+	ID          uint      `json:"id"`
+	UserID      uint      `json:"user_id"`
+	TicketID    uint      `json:"ticket_id"`
+	Description string    `json:"description"`
+	StartedAt   time.Time `json:"started_at"`
+	Ticket      *Ticket   `json:"ticket,omitempty"`
+}
+
+type StartTimerRequest struct {
+	Description string `json:"description"`
+}
+
+// StopTimerRequest replaces the description given when the timer started
+type StopTimerRequest struct {
+	Description *string `json:"description"`
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Timesheet states. A submitted or approved timesheet locks the time logs of
+// its week, a rejected one can be changed and submitted again.
+const (
+	TimesheetStatusDraft     = "draft"
+	TimesheetStatusSubmitted = "submitted"
+	TimesheetStatusApproved  = "approved"
+	TimesheetStatusRejected  = "rejected"
+)
+
+var (
+	ErrTimesheetLocked       = errors.New("the timesheet of this week is submitted or approved, its time logs cannot change")
+	ErrTimesheetNotSubmitted = errors.New("only submitted timesheets can be approved or rejected")
+)
+
+// Timesheet is the week of time logs of a user in an organization. Weeks start
+// on Monday, in UTC.
+type Timesheet struct {
+	ID             uint       `json:"id"`
+	OrganizationID uint       `json:"organization_id"`
+	UserID         uint       `json:"user_id"`
+	WeekStart      time.Time  `json:"week_start"`
+	Status         string     `json:"status"`
+	TotalHours     float64    `json:"total_hours"`
+	SubmittedAt    *time.Time `json:"submitted_at"`
+	ReviewedBy     *uint      `json:"reviewed_by"`
+	ReviewedAt     *time.Time `json:"reviewed_at"`
+	ReviewComment  string     `json:"review_comment"`
+	User           *User      `json:"user,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// Locked reports whether the time logs of the week can no longer change
+func (t *Timesheet) Locked() bool {
+	return t.Status == TimesheetStatusSubmitted || t.Status == TimesheetStatusApproved
+}
+
+// TimesheetView is the timesheet with its hours per ticket and day, Days holds
+// the seven dates of the week and every Hours has one entry per day
+type TimesheetView struct {
+	*Timesheet
+	Days        []string        `json:"days"`
+	Rows        []*TimesheetRow `json:"rows"`
+	DailyTotals []float64       `json:"daily_totals"`
+}
+
+type TimesheetRow struct {
+	TicketID uint      `json:"ticket_id"`
+	Ticket   *Ticket   `json:"ticket,omitempty"`
+	Hours    []float64 `json:"hours"`
+	Total    float64   `json:"total"`
+}
+
+// ReviewTimesheetRequest carries the reason of a rejection, it is optional
+// when approving
+type ReviewTimesheetRequest struct {
+	Comment string `json:"comment" validate:"max=1000"`
+}
+
+// WeekStart returns the Monday of the week of t, at midnight UTC
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekStart(t *testing.T) {
+	monday := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want time.Time
+	}{
+		{name: "monday midnight", t: monday, want: monday},
+		{name: "monday evening", t: time.Date(2024, 3, 4, 23, 59, 0, 0, time.UTC), want: monday},
+		{name: "wednesday", t: time.Date(2024, 3, 6, 12, 0, 0, 0, time.UTC), want: monday},
+		{name: "sunday", t: time.Date(2024, 3, 10, 23, 59, 59, 0, time.UTC), want: monday},
+		{name: "next monday", t: time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), want: monday.AddDate(0, 0, 7)},
+		{name: "across months", t: time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC), want: time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC)},
+		{name: "across years", t: time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC), want: time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day", t: time.Date(2024, 2, 29, 8, 0, 0, 0, time.UTC), want: time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC)},
+		// Monday 00:30 in CET is still Sunday in UTC
+		{name: "other zone", t: time.Date(2024, 3, 11, 0, 30, 0, 0, time.FixedZone("CET", 3600)), want: monday},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WeekStart(tt.t)
+			if !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Fatalf("WeekStart(%v) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimesheetLocked(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{status: TimesheetStatusDraft, want: false},
+		{status: TimesheetStatusSubmitted, want: true},
+		{status: TimesheetStatusApproved, want: true},
+		{status: TimesheetStatusRejected, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			if got := (&Timesheet{Status: tt.status}).Locked(); got != tt.want {
+				t.Fatalf("Locked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrUploadOffsetMismatch = errors.New("upload offset does not match the current offset")
+	ErrUploadExpired        = errors.New("upload has expired")
+	ErrUploadCompleted      = errors.New("upload is already completed")
+	ErrChecksumMismatch     = errors.New("checksum mismatch")
+)
+
+// AttachmentUpload is a resumable (tus) upload in progress. Chunks are stored in
+// the file storage until the upload completes, then they are joined into the
+// attachment.
+type AttachmentUpload struct {
+	ID           uint        `json:"-"`
+	UploadID     string      `json:"upload_id"`
+	ProjectID    uint        `json:"project_id"`
+	TicketID     uint        `json:"ticket_id"`
+	UserID       uint        `json:"user_id"`
+	Filename     string      `json:"filename"`
+	Length       int64       `json:"length"`
+	Offset       int64       `json:"offset"`
+	ChunkCount   int         `json:"chunk_count"`
+	MimeType     string      `json:"mime_type"`
+	Checksum     string      `json:"checksum"` // expected "sha256 <base64>" of the whole file, optional
+	HashState    []byte      `json:"-"`        // running sha256 of the received bytes
+	ExpiresAt    time.Time   `json:"expires_at"`
+	CompletedAt  *time.Time  `json:"completed_at"`
+	AttachmentID *uint       `json:"attachment_id"`
+	Attachment   *Attachment `json:"attachment,omitempty"`
+}
+
+type CreateUploadRequest struct {
+	Length   int64
+	Filename string
+	Checksum string
+}
+
+// UploadChunkRequest is one PATCH request of a tus upload. Checksum is the
+// optional Upload-Checksum header ("<algorithm> <base64 digest>").
+type UploadChunkRequest struct {
+	Offset   int64
+	Checksum string
+	Content  []byte
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "errors"
+
+var ErrStorageQuotaExceeded = errors.New("the organization has reached the storage quota of its plan")
+
+type OrganizationUsage struct {
+	OrganizationID uint   `json:"organization_id"`
+	PlanType       string `json:"plan_type"`
+	StorageBytes   int64  `json:"storage_bytes"`
+	StorageQuota   *int64 `json:"storage_quota"` // null when unlimited
+}
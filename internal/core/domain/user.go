@@ -48,3 +48,18 @@ type UpdateUserRequest struct {
 	LanguagePreference string    `json:"language_preference"`
 	TimeZone           string    `json:"time_zone"`
 }
+
+// User preference keys
+const (
+	PreferenceAutoWatch = "auto_watch" // "false" opts out of being added as a watcher automatically
+)
+
+type UserPreference struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Context string `json:"context"`
+}
+
+type UpdateAutoWatchRequest struct {
+	Enabled *bool `json:"enabled" validate:"required"`
+}
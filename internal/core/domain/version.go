@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Relations between a ticket and a version
+const (
+	VersionRelationFix     = "fix"     // the ticket is delivered in the version
+	VersionRelationAffects = "affects" // the bug is present in the version
+)
+
+var (
+	ErrVersionExists   = errors.New("a version with this name already exists in the project")
+	ErrVersionReleased = errors.New("version is already released")
+	// ErrUnresolvedTickets is returned with the number of tickets in front
+	ErrUnresolvedTickets = errors.New("unresolved tickets have no version to move to, create the next version or pass move_to_version_id")
+)
+
+type Version struct {
+	ID          uint       `json:"id"`
+	ProjectID   uint       `json:"project_id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	ReleaseDate *time.Time `json:"release_date"`
+	IsReleased  bool       `json:"is_released"`
+	IsActive    bool       `json:"is_active"`
+	ReleasedAt  *time.Time `json:"released_at"`
+	ReleasedBy  *uint      `json:"released_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type CreateVersionRequest struct {
+	Name        string     `json:"name" validate:"required,min=1,max=100"`
+	Description string     `json:"description"`
+	ReleaseDate *time.Time `json:"release_date"`
+}
+
+// UpdateVersionRequest only changes the fields that are present in the body
+type UpdateVersionRequest struct {
+	Name        *string    `json:"name" validate:"omitempty,min=1,max=100"`
+	Description *string    `json:"description"`
+	ReleaseDate *time.Time `json:"release_date"`
+	IsActive    *bool      `json:"is_active"`
+}
+
+// ReleaseVersionRequest releases a version. Unresolved tickets fixed in it are
+// moved to MoveToVersionID, or to the next unreleased version of the project.
+type ReleaseVersionRequest struct {
+	MoveToVersionID *uint      `json:"move_to_version_id" validate:"omitempty,min=1"`
+	ReleaseDate     *time.Time `json:"release_date"`
+}
+
+// ReleaseResult is the released version with the unresolved tickets that were
+// moved out of it.
+type ReleaseResult struct {
+	Version        *Version `json:"version"`
+	MovedTo        *Version `json:"moved_to,omitempty"`
+	MovedTicketIDs []uint   `json:"moved_ticket_ids"`
+}
+
+type AddTicketVersionRequest struct {
+	VersionID uint   `json:"version_id" validate:"required,min=1"`
+	Relation  string `json:"relation" validate:"required,oneof=fix affects"`
+}
+
+// ReleaseNotes lists the resolved tickets fixed in a version grouped by
+// ticket type.
+type ReleaseNotes struct {
+	Version  *Version               `json:"version"`
+	Sections []*ReleaseNotesSection `json:"sections"`
+}
+
+type ReleaseNotesSection struct {
+	Type    string              `json:"type"`
+	Tickets []*ReleaseNotesItem `json:"tickets"`
+}
+
+type ReleaseNotesItem struct {
+	ID        uint   `json:"id"`
+	TicketKey string `json:"ticket_key"`
+	Title     string `json:"title"`
+}
+
+// Markdown renders the release notes, one heading per ticket type.
+func (n *ReleaseNotes) Markdown() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", n.Version.Name)
+	if n.Version.ReleaseDate != nil {
+		fmt.Fprintf(&b, "Released on %s\n\n", n.Version.ReleaseDate.Format("2006-01-02"))
+	}
+	if n.Version.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", n.Version.Description)
+	}
+	if len(n.Sections) == 0 {
+		b.WriteString("No resolved tickets.\n")
+	}
+
+	for _, section := range n.Sections {
+		fmt.Fprintf(&b, "## %s\n\n", section.Type)
+		for _, ticket := range section.Tickets {
+			fmt.Fprintf(&b, "- **%s** %s\n", ticket.TicketKey, ticket.Title)
+		}
+		b.WriteString("\n")
+	}
+
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
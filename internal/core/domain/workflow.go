@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrTransitionNotAllowed = errors.New("transition is not allowed by the project workflow")
+
+type WorkflowTransition struct {
+	ID                 uint          `json:"id"`
+	ProjectID          uint          `json:"project_id"`
+	TypeID             *uint         `json:"type_id"`
+	FromStatusID       *uint         `json:"from_status_id"`
+	ToStatusID         uint          `json:"to_status_id"`
+	Name               string        `json:"name"`
+	RequireResolution  bool          `json:"require_resolution"`
+	RequireAssignee    bool          `json:"require_assignee"`
+	RequireManageTasks bool          `json:"require_manage_tasks"`
+	ToStatus           *TicketStatus `json:"to_status,omitempty"`
+	CreatedAt          time.Time     `json:"created_at"`
+	UpdatedAt          time.Time     `json:"updated_at"`
+}
+
+type CreateWorkflowTransitionRequest struct {
+	Name               string `json:"name" validate:"required,min=1,max=100"`
+	TypeID             *uint  `json:"type_id" validate:"omitempty,min=1"`
+	FromStatusID       *uint  `json:"from_status_id" validate:"omitempty,min=1"`
+	ToStatusID         uint   `json:"to_status_id" validate:"required,min=1"`
+	RequireResolution  bool   `json:"require_resolution"`
+	RequireAssignee    bool   `json:"require_assignee"`
+	RequireManageTasks bool   `json:"require_manage_tasks"`
+}
+
+type TransitionTicketRequest struct {
+	ToStatusID   uint  `json:"to_status_id" validate:"required,min=1"`
+	ResolutionID *uint `json:"resolution_id" validate:"omitempty,min=1"`
+}
+
+// TransitionResult is the transitioned ticket with the WIP limits it went
+// over on boards in warn mode.
+type TransitionResult struct {
+	Ticket   *Ticket  `json:"ticket"`
+	Warnings []string `json:"warnings"`
+}
+
+// TicketStatusChange is the set of columns written together when a ticket
+// moves to another status.
+type TicketStatusChange struct {
+	StatusID     uint
+	ResolutionID *uint
+	ResolvedAt   *time.Time
+	ResolvedBy   *uint
+}
+
+type Resolution struct {
+	ID          uint   `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsDefault   bool   `json:"is_default"`
+}
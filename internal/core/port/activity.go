@@ -0,0 +1,18 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ActivityRepository interface {
+	CreateActivities(ctx *fiber.Ctx, activities []*domain.TicketActivity) error
+	GetTicketActivities(ctx *fiber.Ctx, projectID uint, ticketID uint) (int64, int64, int64, []*domain.TicketActivity, error)
+	GetProjectActivities(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.TicketActivity, error)
+}
+
+type ActivityService interface {
+	GetTicketActivities(ctx *fiber.Ctx, projectID uint, ticketID uint) (int64, int64, int64, []*domain.TicketActivity, error)
+	GetProjectActivities(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.TicketActivity, error)
+}
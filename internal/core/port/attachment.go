@@ -0,0 +1,27 @@
+package port
+
+import (
+	"io"
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AttachmentRepository interface {
+	GetAttachments(ctx *fiber.Ctx, ticketID uint) ([]*domain.Attachment, error)
+	GetAttachmentByID(ctx *fiber.Ctx, ticketID uint, id uint) (*domain.Attachment, error)
+	// GetActiveAttachment finds an attachment whose ticket and project are not deleted.
+	GetActiveAttachment(ctx *fiber.Ctx, id uint) (*domain.Attachment, error)
+	CreateAttachment(ctx *fiber.Ctx, attachment *domain.Attachment) error
+	DeleteAttachment(ctx *fiber.Ctx, ticketID uint, id uint) error
+}
+
+type AttachmentService interface {
+	GetAttachments(ctx *fiber.Ctx, projectID uint, ticketID uint) ([]*domain.Attachment, error)
+	UploadAttachment(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.UploadAttachmentRequest) (*domain.Attachment, error)
+	DownloadAttachment(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) (*domain.Attachment, io.ReadCloser, error)
+	DownloadThumbnail(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint, size string) (*domain.AttachmentThumbnail, io.ReadCloser, error)
+	DeleteAttachment(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) error
+	CreateSignedURL(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) (*domain.SignedURL, error)
+	DownloadSignedAttachment(ctx *fiber.Ctx, id uint, expires int64, signature string) (*domain.Attachment, io.ReadCloser, error)
+}
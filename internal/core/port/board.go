@@ -0,0 +1,32 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type BoardRepository interface {
+	GetBoards(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Board, error)
+	GetBoardByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Board, error)
+	// CreateBoard creates the board with its columns and their statuses
+	CreateBoard(ctx *fiber.Ctx, board *domain.Board) error
+	// UpdateBoard changes the board fields and, when columns is not nil,
+	// replaces all its columns
+	UpdateBoard(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateBoardRequest, columns []*domain.BoardColumn) (*domain.Board, error)
+	DeleteBoard(ctx *fiber.Ctx, projectID uint, id uint) error
+
+	// GetBoardTickets returns the tickets in the given statuses ordered by rank,
+	// with their type, priority and assignee
+	GetBoardTickets(ctx *fiber.Ctx, projectID uint, statusIDs []uint) ([]*domain.Ticket, error)
+	// GetTicketsByIDs returns the tickets with their type
+	GetTicketsByIDs(ctx *fiber.Ctx, projectID uint, ids []uint) ([]*domain.Ticket, error)
+}
+
+type BoardService interface {
+	GetBoards(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Board, error)
+	GetBoardByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.BoardView, error)
+	CreateBoard(ctx *fiber.Ctx, projectID uint, req *domain.CreateBoardRequest) (*domain.Board, error)
+	UpdateBoard(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateBoardRequest) (*domain.Board, error)
+	DeleteBoard(ctx *fiber.Ctx, projectID uint, id uint) error
+}
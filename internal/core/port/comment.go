@@ -0,0 +1,24 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CommentRepository interface {
+	GetComments(ctx *fiber.Ctx, ticketID uint) (int64, int64, int64, []*domain.TicketComment, error)
+	GetCommentByID(ctx *fiber.Ctx, ticketID uint, id uint) (*domain.TicketComment, error)
+	CreateComment(ctx *fiber.Ctx, comment *domain.TicketComment) error
+	UpdateComment(ctx *fiber.Ctx, comment *domain.TicketComment, content string, editedBy uint) (*domain.TicketComment, error)
+	DeleteComment(ctx *fiber.Ctx, ticketID uint, id uint, deletedBy uint) error
+	GetCommentRevisions(ctx *fiber.Ctx, commentID uint) ([]*domain.TicketCommentRevision, error)
+}
+
+type CommentService interface {
+	GetComments(ctx *fiber.Ctx, projectID uint, ticketID uint) (int64, int64, int64, []*domain.TicketComment, error)
+	CreateComment(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.CreateCommentRequest) (*domain.TicketComment, error)
+	UpdateComment(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint, req *domain.UpdateCommentRequest) (*domain.TicketComment, error)
+	DeleteComment(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) error
+	GetCommentRevisions(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) ([]*domain.TicketCommentRevision, error)
+}
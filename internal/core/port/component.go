@@ -0,0 +1,31 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ComponentRepository interface {
+	GetComponents(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Component, error)
+	GetComponentByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Component, error)
+	GetComponentsByIDs(ctx *fiber.Ctx, projectID uint, ids []uint) ([]*domain.Component, error)
+	CreateComponent(ctx *fiber.Ctx, component *domain.Component) error
+	UpdateComponent(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateComponentRequest) (*domain.Component, error)
+	DeleteComponent(ctx *fiber.Ctx, projectID uint, id uint) error
+
+	// AddTicketComponent returns false when the ticket already has the component
+	AddTicketComponent(ctx *fiber.Ctx, ticketID uint, componentID uint) (bool, error)
+	RemoveTicketComponent(ctx *fiber.Ctx, ticketID uint, componentID uint) error
+}
+
+type ComponentService interface {
+	GetComponents(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Component, error)
+	GetComponentByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Component, error)
+	CreateComponent(ctx *fiber.Ctx, projectID uint, req *domain.CreateComponentRequest) (*domain.Component, error)
+	UpdateComponent(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateComponentRequest) (*domain.Component, error)
+	DeleteComponent(ctx *fiber.Ctx, projectID uint, id uint) error
+
+	AddTicketComponent(ctx *fiber.Ctx, projectID uint, ticketID uint, componentID uint) error
+	RemoveTicketComponent(ctx *fiber.Ctx, projectID uint, ticketID uint, componentID uint) error
+}
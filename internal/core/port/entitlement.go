@@ -0,0 +1,19 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type EntitlementRepository interface {
+	GetPlanType(ctx *fiber.Ctx, organizationID uint) (string, error)
+}
+
+// EntitlementService decides what the plan of the current organization allows.
+// Violations are returned as *domain.EntitlementError. The project and member
+// limits are enforced by the ProjectRepository, under a lock.
+type EntitlementService interface {
+	GetPlan(ctx *fiber.Ctx, organizationID uint) (*domain.Plan, error)
+	CheckFeature(ctx *fiber.Ctx, feature string) error
+}
@@ -0,0 +1,37 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type LabelRepository interface {
+	GetLabels(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Label, error)
+	GetLabelByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Label, error)
+	// FindLabelByName matches the name case-insensitively, nil when there is none
+	FindLabelByName(ctx *fiber.Ctx, projectID uint, name string) (*domain.Label, error)
+	CreateLabel(ctx *fiber.Ctx, label *domain.Label) error
+	UpdateLabel(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateLabelRequest) (*domain.Label, error)
+	DeleteLabel(ctx *fiber.Ctx, projectID uint, id uint) error
+	// MergeLabels moves the tickets of source to target and deletes source in one
+	// transaction, with both labels locked. It fails with ErrLabelMergeTarget
+	// when the target is gone and returns how many tickets got the target label.
+	MergeLabels(ctx *fiber.Ctx, projectID uint, sourceID uint, targetID uint) (int64, error)
+
+	// AddTicketLabel returns false when the ticket already has the label
+	AddTicketLabel(ctx *fiber.Ctx, ticketID uint, labelID uint) (bool, error)
+	RemoveTicketLabel(ctx *fiber.Ctx, ticketID uint, labelID uint) error
+}
+
+type LabelService interface {
+	GetLabels(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Label, error)
+	GetLabelByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Label, error)
+	CreateLabel(ctx *fiber.Ctx, projectID uint, req *domain.CreateLabelRequest) (*domain.Label, error)
+	UpdateLabel(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateLabelRequest) (*domain.Label, error)
+	DeleteLabel(ctx *fiber.Ctx, projectID uint, id uint) error
+	MergeLabels(ctx *fiber.Ctx, projectID uint, id uint, req *domain.MergeLabelsRequest) (*domain.Label, error)
+
+	AddTicketLabel(ctx *fiber.Ctx, projectID uint, ticketID uint, labelID uint) error
+	RemoveTicketLabel(ctx *fiber.Ctx, projectID uint, ticketID uint, labelID uint) error
+}
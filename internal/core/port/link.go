@@ -0,0 +1,28 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TicketLinkRepository interface {
+	// GetTicketLinks returns the links of both directions seen from the ticket,
+	// with the linked ticket and its status
+	GetTicketLinks(ctx *fiber.Ctx, ticketID uint) ([]*domain.TicketLink, error)
+	GetTicketLinkByID(ctx *fiber.Ctx, ticketID uint, id uint) (*domain.TicketLink, error)
+	// CreateTicketLink fails with ErrLinkExists when the tickets already have a
+	// link of this type and with ErrLinkCycle when a blocks link closes a cycle
+	CreateTicketLink(ctx *fiber.Ctx, link *domain.TicketLink) error
+	DeleteTicketLink(ctx *fiber.Ctx, id uint) error
+
+	// GetOpenBlockedTickets returns the tickets blocked by the ticket that are
+	// not in a closed status
+	GetOpenBlockedTickets(ctx *fiber.Ctx, ticketID uint) ([]*domain.Ticket, error)
+}
+
+type TicketLinkService interface {
+	GetTicketLinks(ctx *fiber.Ctx, projectID uint, ticketID uint) ([]*domain.TicketLink, error)
+	CreateTicketLink(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.CreateTicketLinkRequest) (*domain.TicketLink, error)
+	DeleteTicketLink(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) error
+}
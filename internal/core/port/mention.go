@@ -0,0 +1,16 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type MentionRepository interface {
+	// FindOrganizationUsers returns the members of the current organization whose
+	// email or display name matches one of the handles (case-insensitive).
+	FindOrganizationUsers(ctx *fiber.Ctx, handles []string) ([]*domain.User, error)
+	// CreateMentions stores the mentions that do not exist yet and returns them.
+	CreateMentions(ctx *fiber.Ctx, mentions []*domain.TicketMention) ([]*domain.TicketMention, error)
+	GetMentions(ctx *fiber.Ctx, ticketID uint) ([]*domain.TicketMention, error)
+}
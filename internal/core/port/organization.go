@@ -14,6 +14,7 @@ type OrganizationRepository interface {
 }
 
 type OrganizationService interface {
+	GetUsage(ctx *fiber.Ctx, organizationID uint) (*domain.OrganizationUsage, error)
 	GetOrganization(ctx *fiber.Ctx) (int64, int64, int64, []*domain.Organization, error)
 	GetUserRoleInOrganization(ctx *fiber.Ctx, userID uint) (int64, int64, int64, []*domain.OrganizationMemberRole, error)
 	GetUserRoleInOrganizationByID(ctx *fiber.Ctx, orgId uint, userId uint) (*domain.OrganizationMemberRole, error)
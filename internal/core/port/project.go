@@ -0,0 +1,42 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ProjectRepository interface {
+	GetProjects(ctx *fiber.Ctx, onlyMember bool) (int64, int64, int64, []*domain.Project, error)
+	GetProjectByID(ctx *fiber.Ctx, id uint) (*domain.Project, error)
+	// CreateProject fails with ErrPlanLimitReached when the organization already
+	// has maxProjects active projects, nil is unlimited
+	CreateProject(ctx *fiber.Ctx, project *domain.Project, maxProjects *int64) error
+	// UpdateProject checks maxProjects like CreateProject when it unarchives the
+	// project
+	UpdateProject(ctx *fiber.Ctx, id uint, project *domain.UpdateProjectRequest, maxProjects *int64) (*domain.Project, error)
+	DeleteProject(ctx *fiber.Ctx, id uint) error
+
+	// Member operations
+	GetProjectMembers(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.ProjectMember, error)
+	// AddProjectMember fails with ErrPlanLimitReached when the project already
+	// has maxMembers members, nil is unlimited
+	AddProjectMember(ctx *fiber.Ctx, member *domain.ProjectMember, maxMembers *int64) error
+	RemoveProjectMember(ctx *fiber.Ctx, projectID uint, userID uint) error
+	GetUserRoleInProjectByID(ctx *fiber.Ctx, projectID uint, userID uint) (*domain.ProjectMemberRole, error)
+	IsOrganizationMember(ctx *fiber.Ctx, userID uint) (bool, error)
+}
+
+type ProjectService interface {
+	GetProjects(ctx *fiber.Ctx) (int64, int64, int64, []*domain.Project, error)
+	GetProjectByID(ctx *fiber.Ctx, id uint) (*domain.Project, error)
+	CreateProject(ctx *fiber.Ctx, req *domain.CreateProjectRequest) (*domain.Project, error)
+	UpdateProject(ctx *fiber.Ctx, id uint, req *domain.UpdateProjectRequest) (*domain.Project, error)
+	ArchiveProject(ctx *fiber.Ctx, id uint) (*domain.Project, error)
+	DeleteProject(ctx *fiber.Ctx, id uint) error
+
+	GetProjectMembers(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.ProjectMember, error)
+	AddProjectMember(ctx *fiber.Ctx, projectID uint, req *domain.AddProjectMemberRequest) (*domain.ProjectMember, error)
+	RemoveProjectMember(ctx *fiber.Ctx, projectID uint, userID uint) error
+	GetUserRoleInProjectByID(ctx *fiber.Ctx, projectID uint, userID uint) (*domain.ProjectMemberRole, error)
+}
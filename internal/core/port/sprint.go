@@ -0,0 +1,44 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SprintRepository interface {
+	GetSprints(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Sprint, error)
+	GetSprintByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Sprint, error)
+	CreateSprint(ctx *fiber.Ctx, sprint *domain.Sprint) error
+	UpdateSprint(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateSprintRequest) (*domain.Sprint, error)
+	// DeleteSprint deletes a planned sprint and moves its tickets to the backlog
+	DeleteSprint(ctx *fiber.Ctx, projectID uint, id uint) error
+
+	// StartSprint activates a planned sprint and stores the committed story points
+	StartSprint(ctx *fiber.Ctx, projectID uint, id uint, goal string, startDate time.Time, endDate time.Time) error
+	// CompleteSprint closes an active sprint, stores the completed story points
+	// and moves the unresolved tickets to moveTo (nil for the backlog). It
+	// returns the moved tickets.
+	CompleteSprint(ctx *fiber.Ctx, projectID uint, id uint, moveTo *uint) ([]uint, error)
+
+	// AddSprintTickets moves the tickets into the sprint in one transaction. It
+	// fails with ErrSprintCompleted when the sprint, or the sprint a ticket is
+	// taken out of, is completed. It returns the previous sprint of the tickets
+	// that moved.
+	AddSprintTickets(ctx *fiber.Ctx, projectID uint, id uint, ticketIDs []uint) (map[uint]*uint, error)
+	SetTicketSprint(ctx *fiber.Ctx, projectID uint, ticketID uint, sprintID *uint) error
+}
+
+type SprintService interface {
+	GetSprints(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Sprint, error)
+	GetSprintByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Sprint, error)
+	CreateSprint(ctx *fiber.Ctx, projectID uint, req *domain.CreateSprintRequest) (*domain.Sprint, error)
+	UpdateSprint(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateSprintRequest) (*domain.Sprint, error)
+	DeleteSprint(ctx *fiber.Ctx, projectID uint, id uint) error
+	StartSprint(ctx *fiber.Ctx, projectID uint, id uint, req *domain.StartSprintRequest) (*domain.Sprint, error)
+	CompleteSprint(ctx *fiber.Ctx, projectID uint, id uint, req *domain.CompleteSprintRequest) (*domain.SprintCompletion, error)
+
+	AddSprintTickets(ctx *fiber.Ctx, projectID uint, id uint, req *domain.SprintTicketsRequest) error
+	RemoveSprintTicket(ctx *fiber.Ctx, projectID uint, id uint, ticketID uint) error
+}
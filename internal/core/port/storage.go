@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+	"io"
+)
+
+// FileStorage stores attachment content under a key chosen by the caller.
+type FileStorage interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
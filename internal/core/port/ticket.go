@@ -0,0 +1,72 @@
+package port
+
+import (
+	"context"
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TicketRepository interface {
+	GetTickets(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Ticket, error)
+	GetTicketByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Ticket, error)
+	GetTicketByKey(ctx *fiber.Ctx, key string) (*domain.Ticket, error)
+	// CreateTicket creates the ticket with the first entry of its status history
+	CreateTicket(ctx *fiber.Ctx, ticket *domain.Ticket) error
+	UpdateTicket(ctx *fiber.Ctx, projectID uint, id uint, ticket *domain.UpdateTicketRequest) (*domain.Ticket, error)
+	// TransitionTicket changes the status under the project lock, checkWIP gets
+	// the limited columns the ticket enters and a failing check aborts the change
+	TransitionTicket(ctx *fiber.Ctx, projectID uint, id uint, change *domain.TicketStatusChange, history *domain.TicketStatusHistory, checkWIP func([]*domain.ColumnWIP) error) (*domain.Ticket, error)
+	DeleteTicket(ctx *fiber.Ctx, projectID uint, id uint) error
+
+	// Status history operations
+	GetStatusHistory(ctx *fiber.Ctx, projectID uint, ticketID uint) (int64, int64, int64, []*domain.TicketStatusHistory, error)
+	GetProjectStatusHistory(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.TicketStatusHistory, error)
+
+	// Lookup operations
+	GetTicketStatuses(ctx *fiber.Ctx, projectID uint) ([]*domain.TicketStatus, error)
+	GetTicketStatusByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.TicketStatus, error)
+	GetDefaultTicketStatus(ctx *fiber.Ctx, projectID uint) (*domain.TicketStatus, error)
+	GetTicketTypes(ctx *fiber.Ctx) ([]*domain.TicketType, error)
+	GetTicketTypeByID(ctx *fiber.Ctx, id uint) (*domain.TicketType, error)
+	GetPriorities(ctx *fiber.Ctx) ([]*domain.Priority, error)
+	GetPriorityByID(ctx *fiber.Ctx, id uint) (*domain.Priority, error)
+	IsProjectMember(ctx *fiber.Ctx, projectID uint, userID uint) (bool, error)
+
+	// Hierarchy operations
+	GetChildren(ctx *fiber.Ctx, projectID uint, id uint) ([]*domain.Ticket, error)
+	// GetAncestorIDs returns the parent of the ticket, its parent and so on
+	GetAncestorIDs(ctx *fiber.Ctx, id uint) ([]uint, error)
+
+	// Rank operations
+	// GetNeighbourRank returns the closest rank before (or after) rank in the
+	// project ignoring excludeID, empty at either end of the list
+	GetNeighbourRank(ctx *fiber.Ctx, projectID uint, rank string, before bool, excludeID uint) (string, error)
+	UpdateTicketRank(ctx *fiber.Ctx, projectID uint, id uint, rank string) error
+	// RebalanceRanks gives every ticket of the project a short, evenly spaced
+	// rank keeping their order
+	RebalanceRanks(ctx context.Context, projectID uint) error
+	// GetProjectsToRebalance returns the projects with ranks longer than
+	// maxLength, unranked tickets or duplicated ranks
+	GetProjectsToRebalance(ctx context.Context, maxLength int) ([]uint, error)
+}
+
+type TicketService interface {
+	GetTickets(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Ticket, error)
+	GetTicketByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Ticket, error)
+	BrowseTicket(ctx *fiber.Ctx, key string) (*domain.Ticket, error)
+	CreateTicket(ctx *fiber.Ctx, projectID uint, req *domain.CreateTicketRequest) (*domain.Ticket, error)
+	UpdateTicket(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateTicketRequest) (*domain.Ticket, error)
+	GetAvailableTransitions(ctx *fiber.Ctx, projectID uint, id uint) ([]*domain.WorkflowTransition, error)
+	TransitionTicket(ctx *fiber.Ctx, projectID uint, id uint, req *domain.TransitionTicketRequest) (*domain.TransitionResult, error)
+	DeleteTicket(ctx *fiber.Ctx, projectID uint, id uint) error
+	RankTicket(ctx *fiber.Ctx, projectID uint, id uint, req *domain.RankTicketRequest) (*domain.Ticket, error)
+
+	GetStatusHistory(ctx *fiber.Ctx, projectID uint, ticketID uint) (int64, int64, int64, []*domain.TicketStatusHistory, error)
+	GetProjectStatusHistory(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.TicketStatusHistory, error)
+	GetMentions(ctx *fiber.Ctx, projectID uint, ticketID uint) ([]*domain.TicketMention, error)
+
+	GetTicketStatuses(ctx *fiber.Ctx, projectID uint) ([]*domain.TicketStatus, error)
+	GetTicketTypes(ctx *fiber.Ctx) ([]*domain.TicketType, error)
+	GetPriorities(ctx *fiber.Ctx) ([]*domain.Priority, error)
+}
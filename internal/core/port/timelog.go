@@ -0,0 +1,37 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// TimeLogRepository keeps the actual hours of a ticket equal to the sum of its
+// time logs, in the same transaction as every change of the logs. Changes of
+// logs in a submitted or approved timesheet fail with ErrTimesheetLocked.
+type TimeLogRepository interface {
+	GetTimeLogs(ctx *fiber.Ctx, ticketID uint) (int64, int64, int64, []*domain.TimeLog, error)
+	GetTimeLogByID(ctx *fiber.Ctx, ticketID uint, id uint) (*domain.TimeLog, error)
+	CreateTimeLog(ctx *fiber.Ctx, log *domain.TimeLog) error
+	UpdateTimeLog(ctx *fiber.Ctx, ticketID uint, id uint, req *domain.UpdateTimeLogRequest) (*domain.TimeLog, error)
+	DeleteTimeLog(ctx *fiber.Ctx, ticketID uint, id uint) error
+
+	GetTimer(ctx *fiber.Ctx, userID uint) (*domain.Timer, error)
+	// StartTimer fails with ErrTimerRunning when the user already has a timer
+	StartTimer(ctx *fiber.Ctx, timer *domain.Timer) error
+	// StopTimer removes the timer and creates its time log
+	StopTimer(ctx *fiber.Ctx, timerID uint, log *domain.TimeLog) error
+	DeleteTimer(ctx *fiber.Ctx, timerID uint) error
+}
+
+type TimeLogService interface {
+	GetTimeLogs(ctx *fiber.Ctx, projectID uint, ticketID uint) (int64, int64, int64, []*domain.TimeLog, error)
+	CreateTimeLog(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.CreateTimeLogRequest) (*domain.TimeLog, error)
+	UpdateTimeLog(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint, req *domain.UpdateTimeLogRequest) (*domain.TimeLog, error)
+	DeleteTimeLog(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) error
+
+	GetMyTimer(ctx *fiber.Ctx) (*domain.Timer, error)
+	StartTimer(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.StartTimerRequest) (*domain.Timer, error)
+	StopTimer(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.StopTimerRequest) (*domain.TimeLog, error)
+	DiscardTimer(ctx *fiber.Ctx, projectID uint, ticketID uint) error
+}
@@ -0,0 +1,33 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TimesheetRepository interface {
+	// GetTimesheets lists the timesheets of the organization, of one user when
+	// userID is set
+	GetTimesheets(ctx *fiber.Ctx, organizationID uint, userID *uint, status string) (int64, int64, int64, []*domain.Timesheet, error)
+	GetTimesheetByID(ctx *fiber.Ctx, organizationID uint, id uint) (*domain.Timesheet, error)
+	GetTimesheetByWeek(ctx *fiber.Ctx, organizationID uint, userID uint, weekStart time.Time) (*domain.Timesheet, error)
+	// GetWeekTimeLogs returns the time logs of the user in the week on the
+	// tickets of the organization, with their ticket
+	GetWeekTimeLogs(ctx *fiber.Ctx, organizationID uint, userID uint, weekStart time.Time) ([]*domain.TimeLog, error)
+	// SubmitTimesheet creates the timesheet when needed and submits it, which
+	// locks its time logs. It fails with ErrTimesheetLocked when the week is
+	// already submitted or approved.
+	SubmitTimesheet(ctx *fiber.Ctx, organizationID uint, userID uint, weekStart time.Time) (*domain.Timesheet, error)
+	// ReviewTimesheet approves or rejects a submitted timesheet
+	ReviewTimesheet(ctx *fiber.Ctx, organizationID uint, id uint, status string, reviewerID uint, comment string) (*domain.Timesheet, error)
+}
+
+type TimesheetService interface {
+	GetTimesheets(ctx *fiber.Ctx, userID *uint, status string) (int64, int64, int64, []*domain.Timesheet, error)
+	GetTimesheet(ctx *fiber.Ctx, userID *uint, week time.Time) (*domain.TimesheetView, error)
+	SubmitTimesheet(ctx *fiber.Ctx, week time.Time) (*domain.Timesheet, error)
+	ApproveTimesheet(ctx *fiber.Ctx, id uint, req *domain.ReviewTimesheetRequest) (*domain.Timesheet, error)
+	RejectTimesheet(ctx *fiber.Ctx, id uint, req *domain.ReviewTimesheetRequest) (*domain.Timesheet, error)
+}
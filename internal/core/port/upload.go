@@ -0,0 +1,36 @@
+package port
+
+import (
+	"context"
+	"task-management/internal/core/domain"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UploadRepository interface {
+	CreateUpload(ctx *fiber.Ctx, upload *domain.AttachmentUpload) error
+	GetUpload(ctx *fiber.Ctx, ticketID uint, uploadID string) (*domain.AttachmentUpload, error)
+	// AdvanceUpload claims the current offset and calls store to write the chunk
+	// before the claim is committed, a failing store releases it. It fails with
+	// ErrUploadOffsetMismatch when another request moved the offset first.
+	AdvanceUpload(ctx *fiber.Ctx, upload *domain.AttachmentUpload, chunkSize int64, hashState []byte, mimeType string, store func() error) error
+	// CompleteUpload creates the attachment and marks the upload as completed in
+	// one transaction.
+	CompleteUpload(ctx *fiber.Ctx, upload *domain.AttachmentUpload, attachment *domain.Attachment) error
+	DeleteUpload(ctx *fiber.Ctx, id uint) error
+
+	// GetExpiredUploads returns unfinished uploads that expired before the time
+	GetExpiredUploads(ctx context.Context, before time.Time, limit int) ([]*domain.AttachmentUpload, error)
+	// DeleteExpiredUpload deletes the upload unless it was completed, it tells
+	// whether it was deleted
+	DeleteExpiredUpload(ctx context.Context, id uint, before time.Time) (bool, error)
+}
+
+type UploadService interface {
+	CreateUpload(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.CreateUploadRequest) (*domain.AttachmentUpload, error)
+	GetUpload(ctx *fiber.Ctx, projectID uint, ticketID uint, uploadID string) (*domain.AttachmentUpload, error)
+	WriteChunk(ctx *fiber.Ctx, projectID uint, ticketID uint, uploadID string, req *domain.UploadChunkRequest) (*domain.AttachmentUpload, error)
+	DeleteUpload(ctx *fiber.Ctx, projectID uint, ticketID uint, uploadID string) error
+	MaxSize() int64
+}
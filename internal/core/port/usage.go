@@ -0,0 +1,15 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UsageRepository interface {
+	GetUsage(ctx *fiber.Ctx, organizationID uint) (*domain.OrganizationUsage, error)
+	// ReserveStorage adds size bytes to the usage of the organization unless it
+	// would exceed the quota (nil for unlimited), ErrStorageQuotaExceeded then.
+	ReserveStorage(ctx *fiber.Ctx, organizationID uint, size int64, quota *int64) error
+	ReleaseStorage(ctx *fiber.Ctx, organizationID uint, size int64) error
+}
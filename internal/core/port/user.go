@@ -11,6 +11,8 @@ type UserRepository interface {
 	GetUserByID(ctx *fiber.Ctx, id uint) (*domain.User, error)
 	UpdateUser(ctx *fiber.Ctx, id uint, user *domain.UpdateUserRequest) (*domain.User, error)
 	DeleteUser(ctx *fiber.Ctx, id uint) error
+	GetPreferences(ctx *fiber.Ctx, userID uint) ([]*domain.UserPreference, error)
+	SetPreference(ctx *fiber.Ctx, userID uint, key string, value string) (*domain.UserPreference, error)
 }
 
 type UserService interface {
@@ -18,4 +20,6 @@ type UserService interface {
 	GetUserByID(ctx *fiber.Ctx, id uint) (*domain.User, error)
 	UpdateUser(ctx *fiber.Ctx, id uint, user *domain.UpdateUserRequest) (*domain.User, error)
 	DeleteUser(ctx *fiber.Ctx, id uint) error
+	GetMyPreferences(ctx *fiber.Ctx) ([]*domain.UserPreference, error)
+	UpdateAutoWatch(ctx *fiber.Ctx, req *domain.UpdateAutoWatchRequest) (*domain.UserPreference, error)
 }
@@ -0,0 +1,43 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type VersionRepository interface {
+	GetVersions(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Version, error)
+	GetVersionByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Version, error)
+	FindVersionByName(ctx *fiber.Ctx, projectID uint, name string) (*domain.Version, error)
+	// GetNextVersion returns the first unreleased active version after id by
+	// release date, nil when there is none
+	GetNextVersion(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Version, error)
+	CreateVersion(ctx *fiber.Ctx, version *domain.Version) error
+	UpdateVersion(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateVersionRequest) (*domain.Version, error)
+	DeleteVersion(ctx *fiber.Ctx, projectID uint, id uint) error
+
+	// ReleaseVersion marks the version released and moves its unresolved tickets
+	// to nextID in one transaction, it returns the moved tickets. Without nextID
+	// it fails with ErrUnresolvedTickets when the version has unresolved tickets.
+	ReleaseVersion(ctx *fiber.Ctx, projectID uint, id uint, nextID *uint, releaseDate time.Time, releasedBy uint) ([]uint, error)
+	GetReleaseNotesTickets(ctx *fiber.Ctx, projectID uint, versionID uint) ([]*domain.Ticket, error)
+
+	// AddTicketVersion returns false when the ticket already has the version
+	AddTicketVersion(ctx *fiber.Ctx, ticketID uint, versionID uint, relation string) (bool, error)
+	RemoveTicketVersion(ctx *fiber.Ctx, ticketID uint, versionID uint, relation string) error
+}
+
+type VersionService interface {
+	GetVersions(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Version, error)
+	GetVersionByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Version, error)
+	CreateVersion(ctx *fiber.Ctx, projectID uint, req *domain.CreateVersionRequest) (*domain.Version, error)
+	UpdateVersion(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateVersionRequest) (*domain.Version, error)
+	DeleteVersion(ctx *fiber.Ctx, projectID uint, id uint) error
+	ReleaseVersion(ctx *fiber.Ctx, projectID uint, id uint, req *domain.ReleaseVersionRequest) (*domain.ReleaseResult, error)
+	GetReleaseNotes(ctx *fiber.Ctx, projectID uint, id uint) (*domain.ReleaseNotes, error)
+
+	AddTicketVersion(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.AddTicketVersionRequest) error
+	RemoveTicketVersion(ctx *fiber.Ctx, projectID uint, ticketID uint, versionID uint, relation string) error
+}
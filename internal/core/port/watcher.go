@@ -0,0 +1,22 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WatcherRepository interface {
+	GetWatchers(ctx *fiber.Ctx, ticketID uint) ([]*domain.User, error)
+	// AddWatchers returns the users that were not watching the ticket yet.
+	AddWatchers(ctx *fiber.Ctx, ticketID uint, userIDs []uint) ([]uint, error)
+	RemoveWatcher(ctx *fiber.Ctx, ticketID uint, userID uint) error
+	// GetAutoWatchOptOuts returns the users that disabled the global auto_watch preference.
+	GetAutoWatchOptOuts(ctx *fiber.Ctx, userIDs []uint) ([]uint, error)
+}
+
+type WatcherService interface {
+	GetWatchers(ctx *fiber.Ctx, projectID uint, ticketID uint) ([]*domain.User, error)
+	Watch(ctx *fiber.Ctx, projectID uint, ticketID uint) error
+	Unwatch(ctx *fiber.Ctx, projectID uint, ticketID uint) error
+}
@@ -0,0 +1,26 @@
+package port
+
+import (
+	"task-management/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WorkflowRepository interface {
+	GetTransitions(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.WorkflowTransition, error)
+	GetTransitionsForType(ctx *fiber.Ctx, projectID uint, typeID uint) ([]*domain.WorkflowTransition, error)
+	CreateTransition(ctx *fiber.Ctx, transition *domain.WorkflowTransition) error
+	DeleteTransition(ctx *fiber.Ctx, projectID uint, id uint) error
+
+	// Resolution operations
+	GetResolutions(ctx *fiber.Ctx) ([]*domain.Resolution, error)
+	GetResolutionByID(ctx *fiber.Ctx, id uint) (*domain.Resolution, error)
+	GetDefaultResolution(ctx *fiber.Ctx) (*domain.Resolution, error)
+}
+
+type WorkflowService interface {
+	GetTransitions(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.WorkflowTransition, error)
+	CreateTransition(ctx *fiber.Ctx, projectID uint, req *domain.CreateWorkflowTransitionRequest) (*domain.WorkflowTransition, error)
+	DeleteTransition(ctx *fiber.Ctx, projectID uint, id uint) error
+	GetResolutions(ctx *fiber.Ctx) ([]*domain.Resolution, error)
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"strconv"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ActivityService struct {
+	aRepo port.ActivityRepository
+	tRepo port.TicketRepository
+}
+
+func NewActivityService(aRepo port.ActivityRepository, tRepo port.TicketRepository) *ActivityService {
+	return &ActivityService{aRepo: aRepo, tRepo: tRepo}
+}
+
+func (s *ActivityService) GetTicketActivities(ctx *fiber.Ctx, projectID uint, ticketID uint) (int64, int64, int64, []*domain.TicketActivity, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return 0, 0, 0, nil, err
+	}
+	return s.aRepo.GetTicketActivities(ctx, projectID, ticketID)
+}
+
+func (s *ActivityService) GetProjectActivities(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.TicketActivity, error) {
+	return s.aRepo.GetProjectActivities(ctx, projectID)
+}
+
+// newActivity builds an activity entry of a ticket for the given user.
+func newActivity(ticket *domain.Ticket, userID uint, action string, field string, oldValue *string, newValue *string) *domain.TicketActivity {
+	return &domain.TicketActivity{
+		TicketID:  ticket.ID,
+		ProjectID: ticket.ProjectID,
+		UserID:    userID,
+		Action:    action,
+		Field:     field,
+		OldValue:  oldValue,
+		NewValue:  newValue,
+	}
+}
+
+// ticketChanges compares two versions of a ticket and returns one "updated"
+// activity per field that changed.
+func ticketChanges(before *domain.Ticket, after *domain.Ticket, userID uint) []*domain.TicketActivity {
+	fields := []struct {
+		name     string
+		oldValue *string
+		newValue *string
+	}{
+		{"title", &before.Title, &after.Title},
+		{"description", &before.Description, &after.Description},
+		{"type_id", formatID(&before.TypeID), formatID(&after.TypeID)},
+		{"priority_id", formatID(&before.PriorityID), formatID(&after.PriorityID)},
+		{"assignee_id", formatID(before.AssigneeID), formatID(after.AssigneeID)},
+		{"parent_id", formatID(before.ParentID), formatID(after.ParentID)},
+		{"estimated_hours", formatFloat(before.EstimatedHours), formatFloat(after.EstimatedHours)},
+		{"due_date", formatTime(before.DueDate), formatTime(after.DueDate)},
+		{"story_points", formatInt(before.StoryPoints), formatInt(after.StoryPoints)},
+		{"resolution_id", formatID(before.ResolutionID), formatID(after.ResolutionID)},
+	}
+
+	activities := []*domain.TicketActivity{}
+	for _, field := range fields {
+		if equalValues(field.oldValue, field.newValue) {
+			continue
+		}
+		activities = append(activities, newActivity(after, userID, domain.ActivityActionUpdated, field.name, field.oldValue, field.newValue))
+	}
+	return activities
+}
+
+func equalValues(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func formatID(id *uint) *string {
+	if id == nil {
+		return nil
+	}
+	value := strconv.FormatUint(uint64(*id), 10)
+	return &value
+}
+
+func formatInt(n *int) *string {
+	if n == nil {
+		return nil
+	}
+	value := strconv.Itoa(*n)
+	return &value
+}
+
+func formatFloat(f *float64) *string {
+	if f == nil {
+		return nil
+	}
+	value := strconv.FormatFloat(*f, 'f', -1, 64)
+	return &value
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	value := t.UTC().Format(time.RFC3339)
+	return &value
+}
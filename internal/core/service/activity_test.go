@@ -0,0 +1,89 @@
+package service
+
+import (
+	"task-management/internal/core/domain"
+	"testing"
+	"time"
+)
+
+func TestTicketChanges(t *testing.T) {
+	assignee, other := uint(3), uint(4)
+	hours, points := 2.5, 5
+	due := time.Date(2024, 3, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	base := func() *domain.Ticket {
+		return &domain.Ticket{
+			ID:          10,
+			ProjectID:   1,
+			Title:       "Login fails",
+			Description: "Steps to reproduce",
+			TypeID:      1,
+			StatusID:    1,
+			PriorityID:  2,
+			AssigneeID:  &assignee,
+			StoryPoints: &points,
+		}
+	}
+
+	// change is a field with its old and new values, "-" standing for nil
+	type change struct {
+		field    string
+		oldValue string
+		newValue string
+	}
+
+	tests := []struct {
+		name   string
+		update func(ticket *domain.Ticket)
+		want   []change
+	}{
+		{name: "unchanged", update: func(ticket *domain.Ticket) {}, want: []change{}},
+		{
+			name:   "title and description",
+			update: func(ticket *domain.Ticket) { ticket.Title = "Login fails on Safari"; ticket.Description = "" },
+			want: []change{
+				{"title", "Login fails", "Login fails on Safari"},
+				{"description", "Steps to reproduce", ""},
+			},
+		},
+		{name: "reassigned", update: func(ticket *domain.Ticket) { ticket.AssigneeID = &other }, want: []change{{"assignee_id", "3", "4"}}},
+		{name: "unassigned", update: func(ticket *domain.Ticket) { ticket.AssigneeID = nil }, want: []change{{"assignee_id", "3", "-"}}},
+		{
+			name:   "same assignee in another pointer",
+			update: func(ticket *domain.Ticket) { same := assignee; ticket.AssigneeID = &same },
+			want:   []change{},
+		},
+		{name: "estimate set", update: func(ticket *domain.Ticket) { ticket.EstimatedHours = &hours }, want: []change{{"estimated_hours", "-", "2.5"}}},
+		{name: "due date in UTC", update: func(ticket *domain.Ticket) { ticket.DueDate = &due }, want: []change{{"due_date", "-", "2024-03-01T11:00:00Z"}}},
+		{name: "story points cleared", update: func(ticket *domain.Ticket) { ticket.StoryPoints = nil }, want: []change{{"story_points", "5", "-"}}},
+		{name: "status is not a field change", update: func(ticket *domain.Ticket) { ticket.StatusID = 2 }, want: []change{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before, after := base(), base()
+			tt.update(after)
+
+			activities := ticketChanges(before, after, 7)
+			if len(activities) != len(tt.want) {
+				t.Fatalf("ticketChanges() returned %d activities, want %d", len(activities), len(tt.want))
+			}
+			for i, activity := range activities {
+				got := change{activity.Field, valueOrDash(activity.OldValue), valueOrDash(activity.NewValue)}
+				if got != tt.want[i] {
+					t.Fatalf("ticketChanges()[%d] = %v, want %v", i, got, tt.want[i])
+				}
+				if activity.TicketID != 10 || activity.ProjectID != 1 || activity.UserID != 7 || activity.Action != domain.ActivityActionUpdated {
+					t.Fatalf("ticketChanges()[%d] = %+v, want an update of ticket 10 by user 7", i, activity)
+				}
+			}
+		})
+	}
+}
+
+func valueOrDash(value *string) string {
+	if value == nil {
+		return "-"
+	}
+	return *value
+}
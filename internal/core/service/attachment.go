@@ -0,0 +1,294 @@
+package service
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AttachmentService struct {
+	atRepo        port.AttachmentRepository
+	tRepo         port.TicketRepository
+	aRepo         port.ActivityRepository
+	quota         storageQuota
+	storage       port.FileStorage
+	maxUploadSize int64
+	urlSecret     []byte
+	urlTTL        time.Duration
+	thumbnails    thumbnailer
+}
+
+func NewAttachmentService(atRepo port.AttachmentRepository, tRepo port.TicketRepository, aRepo port.ActivityRepository, uRepo port.UsageRepository, storage port.FileStorage, maxUploadSize int64, urlSecret string, urlTTL time.Duration) *AttachmentService {
+	quota := storageQuota{uRepo: uRepo}
+	return &AttachmentService{
+		atRepo:        atRepo,
+		tRepo:         tRepo,
+		aRepo:         aRepo,
+		quota:         quota,
+		storage:       storage,
+		maxUploadSize: maxUploadSize,
+		urlSecret:     []byte(urlSecret),
+		urlTTL:        urlTTL,
+		thumbnails:    thumbnailer{storage: storage, quota: quota},
+	}
+}
+
+func (s *AttachmentService) GetAttachments(ctx *fiber.Ctx, projectID uint, ticketID uint) ([]*domain.Attachment, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return nil, err
+	}
+	return s.atRepo.GetAttachments(ctx, ticketID)
+}
+
+// UploadAttachment stores the file and records it on the ticket. The content
+// type is detected from the first bytes of the file, the one sent by the client
+// is ignored.
+func (s *AttachmentService) UploadAttachment(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.UploadAttachmentRequest) (*domain.Attachment, error) {
+	userID := ctx.Locals("user_id").(uint)
+
+	if req.Size > s.maxUploadSize {
+		return nil, domain.ErrFileTooLarge
+	}
+	if req.Size == 0 {
+		return nil, errors.New("file is empty")
+	}
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(req.Content, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	head = head[:n]
+	mimeType := http.DetectContentType(head)
+
+	key, err := attachmentKey(projectID, ticket.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.quota.reserve(ctx, req.Size); err != nil {
+		return nil, err
+	}
+
+	// Images are kept in memory to generate their thumbnails, they are at most
+	// maxUploadSize bytes
+	var imageData *bytes.Buffer
+	content := io.MultiReader(bytes.NewReader(head), req.Content)
+	if thumbnailMimeTypes[mimeType] {
+		imageData = bytes.NewBuffer(make([]byte, 0, req.Size))
+		content = io.TeeReader(content, imageData)
+	}
+
+	if err := s.storage.Put(ctx.UserContext(), key, content, req.Size, mimeType); err != nil {
+		s.quota.release(ctx, req.Size)
+		return nil, err
+	}
+
+	attachment := &domain.Attachment{
+		TicketID:   ticket.ID,
+		Filename:   sanitizeFilename(req.Filename),
+		FilePath:   key,
+		FileSize:   req.Size,
+		MimeType:   mimeType,
+		UploadedBy: userID,
+	}
+	if imageData != nil {
+		if err := s.thumbnails.generate(ctx, attachment, imageData.Bytes()); err != nil {
+			s.storage.Delete(ctx.UserContext(), key)
+			s.quota.release(ctx, req.Size)
+			return nil, err
+		}
+	}
+
+	if err := s.atRepo.CreateAttachment(ctx, attachment); err != nil {
+		s.storage.Delete(ctx.UserContext(), key)
+		s.thumbnails.remove(ctx, attachment.Thumbnails)
+		s.quota.release(ctx, req.Size)
+		return nil, err
+	}
+
+	activity := newActivity(ticket, userID, domain.ActivityActionAdded, "attachment", nil, &attachment.Filename)
+	if err := s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity}); err != nil {
+		return nil, err
+	}
+
+	return s.atRepo.GetAttachmentByID(ctx, ticket.ID, attachment.ID)
+}
+
+// DownloadAttachment returns the attachment with its content, the caller must
+// close the reader.
+func (s *AttachmentService) DownloadAttachment(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) (*domain.Attachment, io.ReadCloser, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := s.atRepo.GetAttachmentByID(ctx, ticketID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.storage.Get(ctx.UserContext(), attachment.FilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, content, nil
+}
+
+// DownloadThumbnail returns a thumbnail of an image attachment with its content,
+// the caller must close the reader.
+func (s *AttachmentService) DownloadThumbnail(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint, size string) (*domain.AttachmentThumbnail, io.ReadCloser, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := s.atRepo.GetAttachmentByID(ctx, ticketID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	thumbnail := attachment.Thumbnail(size)
+	if thumbnail == nil {
+		return nil, nil, domain.ErrNoThumbnail
+	}
+
+	content, err := s.storage.Get(ctx.UserContext(), thumbnail.FilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return thumbnail, content, nil
+}
+
+// DeleteAttachment removes an attachment and releases its storage.
+func (s *AttachmentService) DeleteAttachment(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) error {
+	userID := ctx.Locals("user_id").(uint)
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+
+	attachment, err := s.atRepo.GetAttachmentByID(ctx, ticket.ID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.atRepo.DeleteAttachment(ctx, ticket.ID, id); err != nil {
+		return err
+	}
+
+	if err := s.quota.release(ctx, attachment.FileSize+thumbnailsSize(attachment.Thumbnails)); err != nil {
+		return err
+	}
+
+	if err := s.storage.Delete(ctx.UserContext(), attachment.FilePath); err != nil {
+		return err
+	}
+	s.thumbnails.delete(ctx.UserContext(), attachment.Thumbnails)
+
+	activity := newActivity(ticket, userID, domain.ActivityActionRemoved, "attachment", &attachment.Filename, nil)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+// CreateSignedURL returns a download path for the attachment that is valid for
+// the configured TTL without authentication.
+func (s *AttachmentService) CreateSignedURL(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) (*domain.SignedURL, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return nil, err
+	}
+
+	attachment, err := s.atRepo.GetAttachmentByID(ctx, ticketID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(s.urlTTL).Truncate(time.Second)
+	expires := expiresAt.Unix()
+
+	return &domain.SignedURL{
+		URL:       fmt.Sprintf("/api/v1/files/%d?expires=%d&signature=%s", attachment.ID, expires, s.sign(attachment.ID, expires)),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// DownloadSignedAttachment checks the signature and expiry of a signed URL. The
+// attachment is looked up again, so deleting the attachment, its ticket or its
+// project revokes every URL issued for it.
+func (s *AttachmentService) DownloadSignedAttachment(ctx *fiber.Ctx, id uint, expires int64, signature string) (*domain.Attachment, io.ReadCloser, error) {
+	if time.Now().Unix() > expires {
+		return nil, nil, domain.ErrInvalidSignature
+	}
+
+	expected := s.sign(id, expires)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, nil, domain.ErrInvalidSignature
+	}
+
+	attachment, err := s.atRepo.GetActiveAttachment(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.storage.Get(ctx.UserContext(), attachment.FilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, content, nil
+}
+
+func (s *AttachmentService) sign(id uint, expires int64) string {
+	mac := hmac.New(sha256.New, s.urlSecret)
+	fmt.Fprintf(mac, "%d:%d", id, expires)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// attachmentKey returns a random storage key, the original filename is never
+// part of the key.
+func attachmentKey(projectID uint, ticketID uint) (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("projects/%d/tickets/%d/%s", projectID, ticketID, hex.EncodeToString(random)), nil
+}
+
+// sanitizeFilename keeps the base name of the uploaded file without control
+// characters or quotes, which would break the Content-Disposition header.
+func sanitizeFilename(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+
+	if name == "" || name == "." || name == ".." || name == "/" {
+		return "file"
+	}
+	if runes := []rune(name); len(runes) > 255 {
+		name = string(runes[len(runes)-255:])
+	}
+	return name
+}
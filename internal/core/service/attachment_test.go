@@ -0,0 +1,36 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitizeFilename(t *testing.T) {
+	long := strings.Repeat("a", 300) + ".pdf"
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain", in: "report.pdf", want: "report.pdf"},
+		{name: "unicode", in: "résumé 2024.pdf", want: "résumé 2024.pdf"},
+		{name: "unix path", in: "../../etc/passwd", want: "passwd"},
+		{name: "windows path", in: `C:\Users\me\notes.txt`, want: "notes.txt"},
+		{name: "quotes and control characters", in: "a\"b\r\n\x00c\x7f.txt", want: "abc.txt"},
+		{name: "empty", in: "", want: "file"},
+		{name: "dot", in: ".", want: "file"},
+		{name: "parent", in: "dir/..", want: "file"},
+		{name: "root", in: "/", want: "file"},
+		{name: "only control characters", in: "\x01\x02", want: "file"},
+		{name: "too long keeps the extension", in: long, want: long[len(long)-255:]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitizeFilename(tt.in); got != tt.want {
+				t.Fatalf("sanitizeFilename(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,330 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type BoardService struct {
+	bRepo port.BoardRepository
+	tRepo port.TicketRepository
+}
+
+func NewBoardService(bRepo port.BoardRepository, tRepo port.TicketRepository) *BoardService {
+	return &BoardService{bRepo: bRepo, tRepo: tRepo}
+}
+
+func (s *BoardService) GetBoards(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Board, error) {
+	return s.bRepo.GetBoards(ctx, projectID)
+}
+
+// GetBoardByID returns the board with all its tickets grouped by swimlane and
+// column, the tickets are loaded in a single query.
+func (s *BoardService) GetBoardByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.BoardView, error) {
+	board, err := s.bRepo.GetBoardByID(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	columnOf := map[uint]int{}
+	var statusIDs []uint
+	for i, column := range board.Columns {
+		for _, statusID := range column.StatusIDs {
+			columnOf[statusID] = i
+			statusIDs = append(statusIDs, statusID)
+		}
+	}
+
+	var tickets []*domain.Ticket
+	if len(statusIDs) > 0 {
+		if tickets, err = s.bRepo.GetBoardTickets(ctx, projectID, statusIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	lanes, laneOf, err := s.swimlanes(ctx, board, tickets)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &domain.BoardView{
+		Board:     board,
+		Columns:   make([]*domain.BoardColumnWIP, len(board.Columns)),
+		Swimlanes: lanes,
+	}
+	for i, column := range board.Columns {
+		view.Columns[i] = &domain.BoardColumnWIP{ColumnID: column.ID, WIPLimit: column.WIPLimit}
+	}
+
+	for _, ticket := range tickets {
+		i := columnOf[ticket.StatusID]
+		cell := lanes[laneOf[ticket.ID]].Columns[i]
+		cell.Tickets = append(cell.Tickets, ticket)
+		view.Columns[i].TicketCount++
+	}
+	for _, column := range view.Columns {
+		column.OverLimit = column.WIPLimit != nil && column.TicketCount > *column.WIPLimit
+	}
+
+	return view, nil
+}
+
+func (s *BoardService) CreateBoard(ctx *fiber.Ctx, projectID uint, req *domain.CreateBoardRequest) (*domain.Board, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.New("name must not be blank")
+	}
+
+	columns, err := s.boardColumns(ctx, projectID, req.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	board := &domain.Board{
+		ProjectID:  projectID,
+		Name:       name,
+		SwimlaneBy: req.SwimlaneBy,
+		WIPMode:    req.WIPMode,
+		Columns:    columns,
+	}
+	if board.SwimlaneBy == "" {
+		board.SwimlaneBy = domain.SwimlaneNone
+	}
+	if board.WIPMode == "" {
+		board.WIPMode = domain.WIPModeWarn
+	}
+
+	if err := s.bRepo.CreateBoard(ctx, board); err != nil {
+		return nil, err
+	}
+
+	return s.bRepo.GetBoardByID(ctx, projectID, board.ID)
+}
+
+func (s *BoardService) UpdateBoard(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateBoardRequest) (*domain.Board, error) {
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, errors.New("name must not be blank")
+		}
+		req.Name = &name
+	}
+
+	if _, err := s.bRepo.GetBoardByID(ctx, projectID, id); err != nil {
+		return nil, err
+	}
+
+	var columns []*domain.BoardColumn
+	if req.Columns != nil {
+		var err error
+		if columns, err = s.boardColumns(ctx, projectID, req.Columns); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.bRepo.UpdateBoard(ctx, projectID, id, req, columns)
+}
+
+func (s *BoardService) DeleteBoard(ctx *fiber.Ctx, projectID uint, id uint) error {
+	return s.bRepo.DeleteBoard(ctx, projectID, id)
+}
+
+// boardColumns checks that every status belongs to the project and is only
+// mapped to one column of the board.
+func (s *BoardService) boardColumns(ctx *fiber.Ctx, projectID uint, reqs []domain.BoardColumnRequest) ([]*domain.BoardColumn, error) {
+	statuses, err := s.tRepo.GetTicketStatuses(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	projectStatuses := map[uint]bool{}
+	for _, status := range statuses {
+		projectStatuses[status.ID] = true
+	}
+
+	mapped := map[uint]string{}
+	columns := make([]*domain.BoardColumn, len(reqs))
+	for i, req := range reqs {
+		name := strings.TrimSpace(req.Name)
+		if name == "" {
+			return nil, errors.New("column name must not be blank")
+		}
+
+		for _, statusID := range req.StatusIDs {
+			if !projectStatuses[statusID] {
+				return nil, fmt.Errorf("status %d does not belong to this project", statusID)
+			}
+			if other, ok := mapped[statusID]; ok {
+				return nil, fmt.Errorf("status %d is already mapped to column %s", statusID, other)
+			}
+			mapped[statusID] = name
+		}
+
+		columns[i] = &domain.BoardColumn{Name: name, WIPLimit: req.WIPLimit, StatusIDs: req.StatusIDs}
+	}
+
+	return columns, nil
+}
+
+// swimlanes builds the empty swimlanes of the board and returns the index of
+// the swimlane of every ticket. Tickets without a value for the swimlane field
+// go to a last "none" swimlane.
+func (s *BoardService) swimlanes(ctx *fiber.Ctx, board *domain.Board, tickets []*domain.Ticket) ([]*domain.BoardSwimlane, map[uint]int, error) {
+	type lane struct {
+		key   string
+		name  string
+		order string
+		level int
+	}
+
+	laneKeys := make(map[uint]string, len(tickets))
+	lanes := map[string]*lane{}
+	none := &lane{}
+
+	switch board.SwimlaneBy {
+	case domain.SwimlaneAssignee:
+		none.key, none.name = "unassigned", "Unassigned"
+		for _, ticket := range tickets {
+			if ticket.Assignee == nil {
+				continue
+			}
+			key := fmt.Sprintf("assignee:%d", ticket.Assignee.ID)
+			laneKeys[ticket.ID] = key
+			if lanes[key] == nil {
+				name := displayName(ticket.Assignee)
+				lanes[key] = &lane{key: key, name: name, order: strings.ToLower(name)}
+			}
+		}
+
+	case domain.SwimlanePriority:
+		none.key, none.name = "no_priority", "No priority"
+		for _, ticket := range tickets {
+			if ticket.Priority == nil {
+				continue
+			}
+			key := fmt.Sprintf("priority:%d", ticket.Priority.ID)
+			laneKeys[ticket.ID] = key
+			// The most urgent priorities come first
+			lanes[key] = &lane{key: key, name: ticket.Priority.Name, level: -ticket.Priority.Level}
+		}
+
+	case domain.SwimlaneEpic:
+		none.key, none.name = "no_epic", "No epic"
+		epics, err := s.ticketEpics(ctx, board.ProjectID, tickets)
+		if err != nil {
+			return nil, nil, err
+		}
+		for ticketID, epic := range epics {
+			key := fmt.Sprintf("epic:%d", epic.ID)
+			laneKeys[ticketID] = key
+			// Epics keep the order of their own rank
+			lanes[key] = &lane{key: key, name: epic.TicketKey + " " + epic.Title, order: epic.Rank}
+		}
+
+	default:
+		none.key, none.name = "all", "All tickets"
+	}
+
+	ordered := make([]*lane, 0, len(lanes)+1)
+	for _, l := range lanes {
+		ordered = append(ordered, l)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		if ordered[i].level != ordered[j].level {
+			return ordered[i].level < ordered[j].level
+		}
+		if ordered[i].order != ordered[j].order {
+			return ordered[i].order < ordered[j].order
+		}
+		return ordered[i].key < ordered[j].key
+	})
+	ordered = append(ordered, none)
+
+	result := make([]*domain.BoardSwimlane, len(ordered))
+	index := make(map[string]int, len(ordered))
+	for i, l := range ordered {
+		cells := make([]*domain.BoardCell, len(board.Columns))
+		for c, column := range board.Columns {
+			cells[c] = &domain.BoardCell{ColumnID: column.ID, Tickets: []*domain.Ticket{}}
+		}
+		result[i] = &domain.BoardSwimlane{Key: l.key, Name: l.name, Columns: cells}
+		index[l.key] = i
+	}
+
+	laneOf := make(map[uint]int, len(tickets))
+	for _, ticket := range tickets {
+		key, ok := laneKeys[ticket.ID]
+		if !ok {
+			key = none.key
+		}
+		laneOf[ticket.ID] = index[key]
+	}
+
+	return result, laneOf, nil
+}
+
+// ticketEpics finds the epic above every ticket, subtasks go through their
+// parent. The ancestors are loaded one hierarchy level at a time.
+func (s *BoardService) ticketEpics(ctx *fiber.Ctx, projectID uint, tickets []*domain.Ticket) (map[uint]*domain.Ticket, error) {
+	ancestors := map[uint]*domain.Ticket{}
+	var pending []uint
+	for _, ticket := range tickets {
+		if ticket.ParentID != nil {
+			pending = append(pending, *ticket.ParentID)
+		}
+	}
+
+	for len(pending) > 0 {
+		loaded, err := s.bRepo.GetTicketsByIDs(ctx, projectID, pending)
+		if err != nil {
+			return nil, err
+		}
+		pending = nil
+		for _, ancestor := range loaded {
+			ancestors[ancestor.ID] = ancestor
+		}
+		for _, ancestor := range loaded {
+			if isEpic(ancestor) || ancestor.ParentID == nil || ancestors[*ancestor.ParentID] != nil {
+				continue
+			}
+			pending = append(pending, *ancestor.ParentID)
+		}
+	}
+
+	epics := map[uint]*domain.Ticket{}
+	for _, ticket := range tickets {
+		// The depth limit stops on parent cycles left by older data
+		parentID := ticket.ParentID
+		for depth := 0; parentID != nil && depth < len(ancestors); depth++ {
+			ancestor := ancestors[*parentID]
+			if ancestor == nil {
+				break
+			}
+			if isEpic(ancestor) {
+				epics[ticket.ID] = ancestor
+				break
+			}
+			parentID = ancestor.ParentID
+		}
+	}
+	return epics, nil
+}
+
+func isEpic(ticket *domain.Ticket) bool {
+	return ticket.Type != nil && ticket.Type.HierarchyLevel == domain.HierarchyLevelEpic
+}
+
+func displayName(user *domain.User) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	if name := strings.TrimSpace(user.FirstName + " " + user.LastName); name != "" {
+		return name
+	}
+	return user.Email
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CommentService struct {
+	cRepo    port.CommentRepository
+	tRepo    port.TicketRepository
+	aRepo    port.ActivityRepository
+	watchers *autoWatcher
+	mentions *mentionRecorder
+}
+
+func NewCommentService(cRepo port.CommentRepository, tRepo port.TicketRepository, aRepo port.ActivityRepository, mRepo port.MentionRepository, watcherRepo port.WatcherRepository) *CommentService {
+	watchers := &autoWatcher{wRepo: watcherRepo, aRepo: aRepo}
+	return &CommentService{
+		cRepo:    cRepo,
+		tRepo:    tRepo,
+		aRepo:    aRepo,
+		watchers: watchers,
+		mentions: &mentionRecorder{mRepo: mRepo, watchers: watchers},
+	}
+}
+
+func (s *CommentService) GetComments(ctx *fiber.Ctx, projectID uint, ticketID uint) (int64, int64, int64, []*domain.TicketComment, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return 0, 0, 0, nil, err
+	}
+	return s.cRepo.GetComments(ctx, ticketID)
+}
+
+func (s *CommentService) CreateComment(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.CreateCommentRequest) (*domain.TicketComment, error) {
+	userID := ctx.Locals("user_id").(uint)
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &domain.TicketComment{
+		TicketID: ticket.ID,
+		UserID:   userID,
+		Content:  req.Content,
+	}
+	if err := s.cRepo.CreateComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	activity := newActivity(ticket, userID, domain.ActivityActionAdded, "comment", nil, formatID(&comment.ID))
+	if err := s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity}); err != nil {
+		return nil, err
+	}
+
+	if err := s.watchers.add(ctx, ticket, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.mentions.record(ctx, ticket, &comment.ID, comment.Content); err != nil {
+		return nil, err
+	}
+
+	return s.cRepo.GetCommentByID(ctx, ticket.ID, comment.ID)
+}
+
+// UpdateComment lets authors edit their own comments, the previous content is
+// kept as a revision.
+func (s *CommentService) UpdateComment(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint, req *domain.UpdateCommentRequest) (*domain.TicketComment, error) {
+	userID := ctx.Locals("user_id").(uint)
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.cRepo.GetCommentByID(ctx, ticket.ID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.UserID != userID {
+		return nil, domain.ErrForbidden
+	}
+	if comment.Content == req.Content {
+		return comment, nil
+	}
+
+	updated, err := s.cRepo.UpdateComment(ctx, comment, req.Content, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	activity := newActivity(ticket, userID, domain.ActivityActionUpdated, "comment", formatID(&comment.ID), formatID(&comment.ID))
+	if err := s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity}); err != nil {
+		return nil, err
+	}
+
+	if err := s.mentions.record(ctx, ticket, &comment.ID, updated.Content); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteComment soft deletes a comment. Authors can delete their own comments,
+// project members with CanManageTasks can moderate any comment.
+func (s *CommentService) DeleteComment(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) error {
+	userID := ctx.Locals("user_id").(uint)
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+
+	comment, err := s.cRepo.GetCommentByID(ctx, ticket.ID, id)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != userID && !canModerateComments(ctx) {
+		return domain.ErrForbidden
+	}
+
+	if err := s.cRepo.DeleteComment(ctx, ticket.ID, id, userID); err != nil {
+		return err
+	}
+
+	activity := newActivity(ticket, userID, domain.ActivityActionRemoved, "comment", formatID(&comment.ID), nil)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+// GetCommentRevisions returns the previous versions of a comment to its author
+// and to moderators.
+func (s *CommentService) GetCommentRevisions(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) ([]*domain.TicketCommentRevision, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.cRepo.GetCommentByID(ctx, ticketID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.UserID != ctx.Locals("user_id").(uint) && !canModerateComments(ctx) {
+		return nil, domain.ErrForbidden
+	}
+
+	return s.cRepo.GetCommentRevisions(ctx, comment.ID)
+}
+
+func canModerateComments(ctx *fiber.Ctx) bool {
+	role, ok := ctx.Locals("project_role").(*domain.ProjectMemberRole)
+	return ok && role.CanManageTasks
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ComponentService struct {
+	coRepo port.ComponentRepository
+	tRepo  port.TicketRepository
+	aRepo  port.ActivityRepository
+}
+
+func NewComponentService(coRepo port.ComponentRepository, tRepo port.TicketRepository, aRepo port.ActivityRepository) *ComponentService {
+	return &ComponentService{coRepo: coRepo, tRepo: tRepo, aRepo: aRepo}
+}
+
+func (s *ComponentService) GetComponents(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Component, error) {
+	return s.coRepo.GetComponents(ctx, projectID)
+}
+
+func (s *ComponentService) GetComponentByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Component, error) {
+	return s.coRepo.GetComponentByID(ctx, projectID, id)
+}
+
+func (s *ComponentService) CreateComponent(ctx *fiber.Ctx, projectID uint, req *domain.CreateComponentRequest) (*domain.Component, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.New("name must not be blank")
+	}
+
+	if err := s.checkLead(ctx, projectID, req.LeadID); err != nil {
+		return nil, err
+	}
+
+	component := &domain.Component{
+		ProjectID:   projectID,
+		Name:        name,
+		Description: req.Description,
+		LeadID:      req.LeadID,
+	}
+	if err := s.coRepo.CreateComponent(ctx, component); err != nil {
+		return nil, err
+	}
+
+	return s.coRepo.GetComponentByID(ctx, projectID, component.ID)
+}
+
+func (s *ComponentService) UpdateComponent(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateComponentRequest) (*domain.Component, error) {
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, errors.New("name must not be blank")
+		}
+		req.Name = &name
+	}
+
+	if req.LeadID != nil && *req.LeadID != 0 {
+		if err := s.checkLead(ctx, projectID, req.LeadID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.coRepo.UpdateComponent(ctx, projectID, id, req)
+}
+
+func (s *ComponentService) DeleteComponent(ctx *fiber.Ctx, projectID uint, id uint) error {
+	return s.coRepo.DeleteComponent(ctx, projectID, id)
+}
+
+func (s *ComponentService) AddTicketComponent(ctx *fiber.Ctx, projectID uint, ticketID uint, componentID uint) error {
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+
+	component, err := s.coRepo.GetComponentByID(ctx, projectID, componentID)
+	if err != nil {
+		return err
+	}
+	if !component.IsActive {
+		return errors.New("component is inactive")
+	}
+
+	added, err := s.coRepo.AddTicketComponent(ctx, ticket.ID, component.ID)
+	if err != nil || !added {
+		return err
+	}
+
+	activity := newActivity(ticket, ctx.Locals("user_id").(uint), domain.ActivityActionAdded, "component", nil, &component.Name)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+func (s *ComponentService) RemoveTicketComponent(ctx *fiber.Ctx, projectID uint, ticketID uint, componentID uint) error {
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+
+	component, err := s.coRepo.GetComponentByID(ctx, projectID, componentID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.coRepo.RemoveTicketComponent(ctx, ticket.ID, component.ID); err != nil {
+		return err
+	}
+
+	activity := newActivity(ticket, ctx.Locals("user_id").(uint), domain.ActivityActionRemoved, "component", &component.Name, nil)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+// checkLead fails when the component lead is not a member of the project, the
+// lead receives the tickets of the component.
+func (s *ComponentService) checkLead(ctx *fiber.Ctx, projectID uint, leadID *uint) error {
+	if leadID == nil {
+		return nil
+	}
+
+	isMember, err := s.tRepo.IsProjectMember(ctx, projectID, *leadID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return errors.New("lead is not a member of this project")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type EntitlementService struct {
+	eRepo port.EntitlementRepository
+}
+
+func NewEntitlementService(eRepo port.EntitlementRepository) *EntitlementService {
+	return &EntitlementService{eRepo: eRepo}
+}
+
+func (s *EntitlementService) GetPlan(ctx *fiber.Ctx, organizationID uint) (*domain.Plan, error) {
+	planType, err := s.eRepo.GetPlanType(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.GetPlan(planType), nil
+}
+
+func (s *EntitlementService) CheckFeature(ctx *fiber.Ctx, feature string) error {
+	plan, err := s.currentPlan(ctx)
+	if err != nil {
+		return err
+	}
+	if !plan.HasFeature(feature) {
+		return &domain.EntitlementError{PlanType: plan.PlanType, Feature: feature}
+	}
+	return nil
+}
+
+func (s *EntitlementService) currentPlan(ctx *fiber.Ctx) (*domain.Plan, error) {
+	return s.GetPlan(ctx, ctx.Locals("organization_id").(uint))
+}
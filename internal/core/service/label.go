@@ -0,0 +1,151 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type LabelService struct {
+	lRepo port.LabelRepository
+	tRepo port.TicketRepository
+	aRepo port.ActivityRepository
+}
+
+func NewLabelService(lRepo port.LabelRepository, tRepo port.TicketRepository, aRepo port.ActivityRepository) *LabelService {
+	return &LabelService{lRepo: lRepo, tRepo: tRepo, aRepo: aRepo}
+}
+
+func (s *LabelService) GetLabels(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Label, error) {
+	return s.lRepo.GetLabels(ctx, projectID)
+}
+
+func (s *LabelService) GetLabelByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Label, error) {
+	return s.lRepo.GetLabelByID(ctx, projectID, id)
+}
+
+// CreateLabel adds a label to the project. Names are unique per project
+// ignoring case, duplicates that already exist can be merged.
+func (s *LabelService) CreateLabel(ctx *fiber.Ctx, projectID uint, req *domain.CreateLabelRequest) (*domain.Label, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.New("name must not be blank")
+	}
+
+	if err := s.checkNameAvailable(ctx, projectID, 0, name); err != nil {
+		return nil, err
+	}
+
+	label := &domain.Label{
+		ProjectID:   projectID,
+		Name:        name,
+		Color:       req.Color,
+		Description: req.Description,
+	}
+	if err := s.lRepo.CreateLabel(ctx, label); err != nil {
+		return nil, err
+	}
+
+	return label, nil
+}
+
+func (s *LabelService) UpdateLabel(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateLabelRequest) (*domain.Label, error) {
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, errors.New("name must not be blank")
+		}
+		if err := s.checkNameAvailable(ctx, projectID, id, name); err != nil {
+			return nil, err
+		}
+		req.Name = &name
+	}
+
+	return s.lRepo.UpdateLabel(ctx, projectID, id, req)
+}
+
+func (s *LabelService) DeleteLabel(ctx *fiber.Ctx, projectID uint, id uint) error {
+	return s.lRepo.DeleteLabel(ctx, projectID, id)
+}
+
+// MergeLabels moves every ticket of the label to the target label and deletes
+// the label, e.g. to clean up "bug" and "Bug".
+func (s *LabelService) MergeLabels(ctx *fiber.Ctx, projectID uint, id uint, req *domain.MergeLabelsRequest) (*domain.Label, error) {
+	if id == req.TargetID {
+		return nil, errors.New("a label cannot be merged into itself")
+	}
+
+	if _, err := s.lRepo.GetLabelByID(ctx, projectID, id); err != nil {
+		return nil, err
+	}
+
+	target, err := s.lRepo.GetLabelByID(ctx, projectID, req.TargetID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrLabelMergeTarget
+		}
+		return nil, err
+	}
+
+	if _, err := s.lRepo.MergeLabels(ctx, projectID, id, target.ID); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+func (s *LabelService) AddTicketLabel(ctx *fiber.Ctx, projectID uint, ticketID uint, labelID uint) error {
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+
+	label, err := s.lRepo.GetLabelByID(ctx, projectID, labelID)
+	if err != nil {
+		return err
+	}
+
+	added, err := s.lRepo.AddTicketLabel(ctx, ticket.ID, label.ID)
+	if err != nil || !added {
+		return err
+	}
+
+	activity := newActivity(ticket, ctx.Locals("user_id").(uint), domain.ActivityActionAdded, "label", nil, &label.Name)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+func (s *LabelService) RemoveTicketLabel(ctx *fiber.Ctx, projectID uint, ticketID uint, labelID uint) error {
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+
+	label, err := s.lRepo.GetLabelByID(ctx, projectID, labelID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.lRepo.RemoveTicketLabel(ctx, ticket.ID, label.ID); err != nil {
+		return err
+	}
+
+	activity := newActivity(ticket, ctx.Locals("user_id").(uint), domain.ActivityActionRemoved, "label", &label.Name, nil)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+// checkNameAvailable fails when another label of the project (not id) has the
+// name in any case.
+func (s *LabelService) checkNameAvailable(ctx *fiber.Ctx, projectID uint, id uint, name string) error {
+	existing, err := s.lRepo.FindLabelByName(ctx, projectID, name)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != id {
+		return domain.ErrLabelExists
+	}
+	return nil
+}
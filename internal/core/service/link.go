@@ -0,0 +1,145 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type TicketLinkService struct {
+	lRepo port.TicketLinkRepository
+	tRepo port.TicketRepository
+	aRepo port.ActivityRepository
+}
+
+func NewTicketLinkService(lRepo port.TicketLinkRepository, tRepo port.TicketRepository, aRepo port.ActivityRepository) *TicketLinkService {
+	return &TicketLinkService{lRepo: lRepo, tRepo: tRepo, aRepo: aRepo}
+}
+
+// GetTicketLinks returns the links of the ticket, links to tickets of projects
+// the user cannot see are left out.
+func (s *TicketLinkService) GetTicketLinks(ctx *fiber.Ctx, projectID uint, ticketID uint) ([]*domain.TicketLink, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return nil, err
+	}
+
+	links, err := s.lRepo.GetTicketLinks(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := map[uint]bool{projectID: true}
+	result := make([]*domain.TicketLink, 0, len(links))
+	for _, link := range links {
+		otherProjectID := link.Ticket.ProjectID
+		canView, ok := visible[otherProjectID]
+		if !ok {
+			if canView, err = canViewProject(ctx, s.tRepo, otherProjectID); err != nil {
+				return nil, err
+			}
+			visible[otherProjectID] = canView
+		}
+		if canView {
+			result = append(result, link)
+		}
+	}
+	return result, nil
+}
+
+func (s *TicketLinkService) CreateTicketLink(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.CreateTicketLinkRequest) (*domain.TicketLink, error) {
+	userID := ctx.Locals("user_id").(uint)
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The target is looked up in the whole organization
+	target, err := s.tRepo.GetTicketByKey(ctx, strings.ToUpper(strings.TrimSpace(req.TargetKey)))
+	if err != nil {
+		return nil, err
+	}
+	canView, err := canViewProject(ctx, s.tRepo, target.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if target.ID == ticket.ID {
+		return nil, errors.New("a ticket cannot be linked to itself")
+	}
+
+	linkType, swap := domain.NormalizeLinkType(req.LinkType)
+	source, dest := ticket, target
+	if swap {
+		source, dest = target, ticket
+	}
+
+	link := &domain.TicketLink{
+		SourceTicketID: source.ID,
+		TargetTicketID: dest.ID,
+		LinkType:       linkType,
+		CreatedBy:      userID,
+	}
+	if err := s.lRepo.CreateTicketLink(ctx, link); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordLink(ctx, domain.ActivityActionAdded, source, dest, linkType, userID); err != nil {
+		return nil, err
+	}
+
+	return s.lRepo.GetTicketLinkByID(ctx, ticket.ID, link.ID)
+}
+
+func (s *TicketLinkService) DeleteTicketLink(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) error {
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+
+	link, err := s.lRepo.GetTicketLinkByID(ctx, ticket.ID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.lRepo.DeleteTicketLink(ctx, link.ID); err != nil {
+		return err
+	}
+
+	// The other ticket is not loaded when it was deleted
+	if link.Ticket == nil {
+		return nil
+	}
+	source, target := ticket, link.Ticket
+	if link.Direction == domain.LinkDirectionInward {
+		source, target = link.Ticket, ticket
+	}
+	return s.recordLink(ctx, domain.ActivityActionRemoved, source, target, link.LinkType, ctx.Locals("user_id").(uint))
+}
+
+// recordLink records the link change on both tickets, each one with the
+// description seen from it (e.g. "blocks WEB-2" and "is blocked by WEB-1").
+func (s *TicketLinkService) recordLink(ctx *fiber.Ctx, action string, source *domain.Ticket, target *domain.Ticket, linkType string, userID uint) error {
+	outward := domain.LinkDescription(linkType, domain.LinkDirectionOutward) + " " + target.TicketKey
+	inward := domain.LinkDescription(linkType, domain.LinkDirectionInward) + " " + source.TicketKey
+
+	var activities []*domain.TicketActivity
+	if action == domain.ActivityActionAdded {
+		activities = []*domain.TicketActivity{
+			newActivity(source, userID, action, "link", nil, &outward),
+			newActivity(target, userID, action, "link", nil, &inward),
+		}
+	} else {
+		activities = []*domain.TicketActivity{
+			newActivity(source, userID, action, "link", &outward, nil),
+			newActivity(target, userID, action, "link", &inward, nil),
+		}
+	}
+	return s.aRepo.CreateActivities(ctx, activities)
+}
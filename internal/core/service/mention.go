@@ -0,0 +1,102 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// mentionPattern matches @display_name and @email handles that start a word,
+// so the domain part of a plain email address is not taken as a mention.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@([\w.+\-]+(?:@[\w\-]+(?:\.[\w\-]+)+)?)`)
+
+// mentionRecorder stores the mentions found in descriptions and comments and
+// adds the mentioned users as watchers of the ticket.
+type mentionRecorder struct {
+	mRepo    port.MentionRepository
+	watchers *autoWatcher
+}
+
+// record resolves the handles in text among the members of the current
+// organization. Handles that match nobody, or more than one user, are ignored
+// so that mentions never reveal users of other organizations.
+func (m *mentionRecorder) record(ctx *fiber.Ctx, ticket *domain.Ticket, commentID *uint, text string) error {
+	handles := parseMentions(text)
+	if len(handles) == 0 {
+		return nil
+	}
+
+	users, err := m.mRepo.FindOrganizationUsers(ctx, handles)
+	if err != nil {
+		return err
+	}
+
+	userID := ctx.Locals("user_id").(uint)
+	mentions := []*domain.TicketMention{}
+	for _, handle := range handles {
+		mentioned := resolveMention(handle, users)
+		if mentioned == nil || mentioned.ID == userID {
+			continue
+		}
+		mentions = append(mentions, &domain.TicketMention{
+			TicketID:    ticket.ID,
+			CommentID:   commentID,
+			UserID:      mentioned.ID,
+			MentionedBy: userID,
+		})
+	}
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	created, err := m.mRepo.CreateMentions(ctx, mentions)
+	if err != nil || len(created) == 0 {
+		return err
+	}
+
+	watcherIDs := make([]uint, len(created))
+	for i, mention := range created {
+		watcherIDs[i] = mention.UserID
+	}
+	return m.watchers.add(ctx, ticket, watcherIDs...)
+}
+
+// parseMentions returns the unique, lower-cased handles mentioned in text.
+func parseMentions(text string) []string {
+	seen := map[string]bool{}
+	handles := []string{}
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		handle := strings.ToLower(strings.TrimRight(match[1], ".-"))
+		if handle == "" || seen[handle] {
+			continue
+		}
+		seen[handle] = true
+		handles = append(handles, handle)
+	}
+	return handles
+}
+
+func resolveMention(handle string, users []*domain.User) *domain.User {
+	var found *domain.User
+	for _, user := range users {
+		var matches bool
+		if strings.Contains(handle, "@") {
+			matches = strings.EqualFold(user.Email, handle)
+		} else {
+			matches = strings.EqualFold(user.DisplayName, handle)
+		}
+		if !matches {
+			continue
+		}
+		if found != nil && found.ID != user.ID {
+			// Ambiguous display name
+			return nil
+		}
+		found = user
+	}
+	return found
+}
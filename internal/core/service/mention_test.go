@@ -0,0 +1,35 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "empty", text: "", want: []string{}},
+		{name: "display name", text: "hi @alice, can you look?", want: []string{"alice"}},
+		{name: "start of text", text: "@bob please review", want: []string{"bob"}},
+		{name: "lower cased and unique", text: "@Alice and @alice and @ALICE", want: []string{"alice"}},
+		{name: "order kept", text: "@carol @bob @carol", want: []string{"carol", "bob"}},
+		{name: "trailing punctuation", text: "thanks @dave. and @erin-", want: []string{"dave", "erin"}},
+		{name: "in parentheses", text: "(@frank)", want: []string{"frank"}},
+		{name: "dotted name", text: "@john.doe_2 fixed it", want: []string{"john.doe_2"}},
+		{name: "email handle", text: "cc @Jane@Example.com.", want: []string{"jane@example.com"}},
+		{name: "plain email address", text: "mail john@example.com", want: []string{}},
+		{name: "double at", text: "@@grace", want: []string{}},
+		{name: "bare at", text: "meet @ noon @-", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseMentions(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseMentions(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
@@ -9,10 +9,15 @@ import (
 
 type OrganizationService struct {
 	oRepo port.OrganizationRepository
+	uRepo port.UsageRepository
 }
 
-func NewOrganizationService(oRepo port.OrganizationRepository) *OrganizationService {
-	return &OrganizationService{oRepo: oRepo}
+func NewOrganizationService(oRepo port.OrganizationRepository, uRepo port.UsageRepository) *OrganizationService {
+	return &OrganizationService{oRepo: oRepo, uRepo: uRepo}
+}
+
+func (s *OrganizationService) GetUsage(ctx *fiber.Ctx, organizationID uint) (*domain.OrganizationUsage, error) {
+	return s.uRepo.GetUsage(ctx, organizationID)
 }
 
 
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"errors"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ProjectService struct {
+	pRepo        port.ProjectRepository
+	entitlements port.EntitlementService
+}
+
+func NewProjectService(pRepo port.ProjectRepository, entitlements port.EntitlementService) *ProjectService {
+	return &ProjectService{pRepo: pRepo, entitlements: entitlements}
+}
+
+func (s *ProjectService) GetProjects(ctx *fiber.Ctx) (int64, int64, int64, []*domain.Project, error) {
+	// Members without CanViewAllProjects only see the projects they belong to
+	role, ok := ctx.Locals("user_role").(*domain.OrganizationMemberRole)
+	onlyMember := !ok || !role.CanViewAllProjects
+
+	return s.pRepo.GetProjects(ctx, onlyMember)
+}
+
+func (s *ProjectService) GetProjectByID(ctx *fiber.Ctx, id uint) (*domain.Project, error) {
+	return s.pRepo.GetProjectByID(ctx, id)
+}
+
+func (s *ProjectService) CreateProject(ctx *fiber.Ctx, req *domain.CreateProjectRequest) (*domain.Project, error) {
+	userID := ctx.Locals("user_id").(uint)
+
+	plan, err := s.entitlements.GetPlan(ctx, ctx.Locals("organization_id").(uint))
+	if err != nil {
+		return nil, err
+	}
+
+	project := &domain.Project{
+		Name:        req.Name,
+		Description: req.Description,
+		Key:         req.Key,
+		OwnerID:     userID,
+		StatusID:    domain.ProjectStatusActive,
+	}
+
+	if err := s.pRepo.CreateProject(ctx, project, plan.MaxProjects); err != nil {
+		return nil, planLimitError(err, plan, domain.LimitProjects, plan.MaxProjects)
+	}
+
+	return project, nil
+}
+
+func (s *ProjectService) UpdateProject(ctx *fiber.Ctx, id uint, req *domain.UpdateProjectRequest) (*domain.Project, error) {
+	if req.OwnerID != 0 {
+		isMember, err := s.pRepo.IsOrganizationMember(ctx, req.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, domain.ErrUserNotInOrganization
+		}
+	}
+
+	// Assigning tickets automatically is part of the automation feature
+	if req.AutoAssignMode != "" && req.AutoAssignMode != domain.AutoAssignNone {
+		if err := s.entitlements.CheckFeature(ctx, domain.FeatureAutomation); err != nil {
+			return nil, err
+		}
+	}
+
+	// Archived projects do not count toward the limit, unarchiving one does
+	plan, err := s.entitlements.GetPlan(ctx, ctx.Locals("organization_id").(uint))
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.pRepo.UpdateProject(ctx, id, req, plan.MaxProjects)
+	if err != nil {
+		return nil, planLimitError(err, plan, domain.LimitProjects, plan.MaxProjects)
+	}
+	return project, nil
+}
+
+func (s *ProjectService) ArchiveProject(ctx *fiber.Ctx, id uint) (*domain.Project, error) {
+	return s.pRepo.UpdateProject(ctx, id, &domain.UpdateProjectRequest{StatusID: domain.ProjectStatusArchived}, nil)
+}
+
+func (s *ProjectService) DeleteProject(ctx *fiber.Ctx, id uint) error {
+	return s.pRepo.DeleteProject(ctx, id)
+}
+
+func (s *ProjectService) GetProjectMembers(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.ProjectMember, error) {
+	return s.pRepo.GetProjectMembers(ctx, projectID)
+}
+
+func (s *ProjectService) AddProjectMember(ctx *fiber.Ctx, projectID uint, req *domain.AddProjectMemberRequest) (*domain.ProjectMember, error) {
+	isMember, err := s.pRepo.IsOrganizationMember(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, domain.ErrUserNotInOrganization
+	}
+
+	plan, err := s.entitlements.GetPlan(ctx, ctx.Locals("organization_id").(uint))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	inviter := ctx.Locals("user_id").(uint)
+	member := &domain.ProjectMember{
+		ProjectID: projectID,
+		UserID:    req.UserID,
+		RoleID:    req.RoleID,
+		StatusID:  1, // Active
+		InvitedAt: &now,
+		JoinedAt:  &now,
+		InvitedBy: &inviter,
+	}
+
+	if err := s.pRepo.AddProjectMember(ctx, member, plan.MaxProjectMembers); err != nil {
+		return nil, planLimitError(err, plan, domain.LimitProjectMembers, plan.MaxProjectMembers)
+	}
+
+	return member, nil
+}
+
+func (s *ProjectService) RemoveProjectMember(ctx *fiber.Ctx, projectID uint, userID uint) error {
+	project, err := s.pRepo.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if project.OwnerID == userID {
+		return errors.New("the project owner cannot be removed from the project")
+	}
+
+	return s.pRepo.RemoveProjectMember(ctx, projectID, userID)
+}
+
+func (s *ProjectService) GetUserRoleInProjectByID(ctx *fiber.Ctx, projectID uint, userID uint) (*domain.ProjectMemberRole, error) {
+	return s.pRepo.GetUserRoleInProjectByID(ctx, projectID, userID)
+}
+
+// planLimitError reports the ErrPlanLimitReached of the repository, which
+// enforces the limits under a lock, as the violation of the plan
+func planLimitError(err error, plan *domain.Plan, limit string, max *int64) error {
+	if errors.Is(err, domain.ErrPlanLimitReached) {
+		return &domain.EntitlementError{PlanType: plan.PlanType, Limit: limit, Max: max}
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"log"
+	"task-management/internal/core/port"
+	"time"
+)
+
+const (
+	// rebalanceRankLength is the rank length from which the rebalance job
+	// gives the project short ranks again
+	rebalanceRankLength = 24
+	// maxRankLength is the longest rank given to a moved ticket, longer ranks
+	// rebalance the project right away
+	maxRankLength = 128
+)
+
+// RankRebalancer periodically rebalances the ticket ranks of projects where
+// repeated moves made them long, or where concurrent moves left duplicates.
+type RankRebalancer struct {
+	tRepo    port.TicketRepository
+	interval time.Duration
+}
+
+func NewRankRebalancer(tRepo port.TicketRepository, interval time.Duration) *RankRebalancer {
+	return &RankRebalancer{tRepo: tRepo, interval: interval}
+}
+
+// Run rebalances once at startup and then every interval until ctx is done
+func (r *RankRebalancer) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Rebalance(ctx); err != nil {
+			log.Printf("[ERROR] Rank rebalance failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *RankRebalancer) Rebalance(ctx context.Context) error {
+	projectIDs, err := r.tRepo.GetProjectsToRebalance(ctx, rebalanceRankLength)
+	if err != nil {
+		return err
+	}
+
+	for _, projectID := range projectIDs {
+		if err := r.tRepo.RebalanceRanks(ctx, projectID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type SprintService struct {
+	sRepo port.SprintRepository
+	tRepo port.TicketRepository
+	aRepo port.ActivityRepository
+}
+
+func NewSprintService(sRepo port.SprintRepository, tRepo port.TicketRepository, aRepo port.ActivityRepository) *SprintService {
+	return &SprintService{sRepo: sRepo, tRepo: tRepo, aRepo: aRepo}
+}
+
+func (s *SprintService) GetSprints(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Sprint, error) {
+	return s.sRepo.GetSprints(ctx, projectID)
+}
+
+func (s *SprintService) GetSprintByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Sprint, error) {
+	return s.sRepo.GetSprintByID(ctx, projectID, id)
+}
+
+func (s *SprintService) CreateSprint(ctx *fiber.Ctx, projectID uint, req *domain.CreateSprintRequest) (*domain.Sprint, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.New("name must not be blank")
+	}
+	if err := checkSprintDates(req.StartDate, req.EndDate); err != nil {
+		return nil, err
+	}
+
+	sprint := &domain.Sprint{
+		ProjectID: projectID,
+		Name:      name,
+		Goal:      req.Goal,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	}
+	if err := s.sRepo.CreateSprint(ctx, sprint); err != nil {
+		return nil, err
+	}
+
+	return sprint, nil
+}
+
+func (s *SprintService) UpdateSprint(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateSprintRequest) (*domain.Sprint, error) {
+	sprint, err := s.sRepo.GetSprintByID(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.State == domain.SprintStateCompleted {
+		return nil, domain.ErrSprintCompleted
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, errors.New("name must not be blank")
+		}
+		req.Name = &name
+	}
+
+	startDate, endDate := sprint.StartDate, sprint.EndDate
+	if req.StartDate != nil {
+		startDate = req.StartDate
+	}
+	if req.EndDate != nil {
+		endDate = req.EndDate
+	}
+	if err := checkSprintDates(startDate, endDate); err != nil {
+		return nil, err
+	}
+
+	return s.sRepo.UpdateSprint(ctx, projectID, id, req)
+}
+
+func (s *SprintService) DeleteSprint(ctx *fiber.Ctx, projectID uint, id uint) error {
+	return s.sRepo.DeleteSprint(ctx, projectID, id)
+}
+
+// StartSprint activates a planned sprint, only one sprint of a project can be
+// active. The story points of its tickets are stored as the commitment.
+func (s *SprintService) StartSprint(ctx *fiber.Ctx, projectID uint, id uint, req *domain.StartSprintRequest) (*domain.Sprint, error) {
+	sprint, err := s.sRepo.GetSprintByID(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	goal := sprint.Goal
+	if req.Goal != nil {
+		goal = *req.Goal
+	}
+	startDate := time.Now()
+	if req.StartDate != nil {
+		startDate = *req.StartDate
+	}
+	endDate := sprint.EndDate
+	if req.EndDate != nil {
+		endDate = req.EndDate
+	}
+	if endDate == nil {
+		return nil, errors.New("end_date is required to start a sprint")
+	}
+	if err := checkSprintDates(&startDate, endDate); err != nil {
+		return nil, err
+	}
+
+	if err := s.sRepo.StartSprint(ctx, projectID, id, goal, startDate, *endDate); err != nil {
+		return nil, err
+	}
+
+	return s.sRepo.GetSprintByID(ctx, projectID, id)
+}
+
+// CompleteSprint closes the active sprint. Tickets that are not in a resolved
+// status are carried over to the requested planned sprint or to the backlog.
+func (s *SprintService) CompleteSprint(ctx *fiber.Ctx, projectID uint, id uint, req *domain.CompleteSprintRequest) (*domain.SprintCompletion, error) {
+	userID := ctx.Locals("user_id").(uint)
+
+	if _, err := s.sRepo.GetSprintByID(ctx, projectID, id); err != nil {
+		return nil, err
+	}
+
+	var next *domain.Sprint
+	if req.MoveToSprintID != nil {
+		if *req.MoveToSprintID == id {
+			return nil, errors.New("tickets cannot be carried over to the sprint being completed")
+		}
+		var err error
+		next, err = s.sRepo.GetSprintByID(ctx, projectID, *req.MoveToSprintID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("target sprint not found in this project")
+			}
+			return nil, err
+		}
+		if next.State != domain.SprintStatePlanned {
+			return nil, errors.New("tickets can only be carried over to a planned sprint")
+		}
+	}
+
+	var moveTo *uint
+	if next != nil {
+		moveTo = &next.ID
+	}
+
+	moved, err := s.sRepo.CompleteSprint(ctx, projectID, id, moveTo)
+	if err != nil {
+		return nil, err
+	}
+
+	activities := make([]*domain.TicketActivity, len(moved))
+	for i, ticketID := range moved {
+		ticket := &domain.Ticket{ID: ticketID, ProjectID: projectID}
+		activities[i] = newActivity(ticket, userID, domain.ActivityActionUpdated, "sprint_id", formatID(&id), formatID(moveTo))
+	}
+	if err := s.aRepo.CreateActivities(ctx, activities); err != nil {
+		return nil, err
+	}
+
+	sprint, err := s.sRepo.GetSprintByID(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	completion := &domain.SprintCompletion{Sprint: sprint, MovedTo: next, CarriedOverTicketIDs: moved}
+	if completion.CarriedOverTicketIDs == nil {
+		completion.CarriedOverTicketIDs = []uint{}
+	}
+	return completion, nil
+}
+
+// AddSprintTickets plans tickets into a sprint, taking them out of the backlog
+// or of another sprint that is not completed.
+func (s *SprintService) AddSprintTickets(ctx *fiber.Ctx, projectID uint, id uint, req *domain.SprintTicketsRequest) error {
+	userID := ctx.Locals("user_id").(uint)
+
+	tickets := make([]*domain.Ticket, 0, len(req.TicketIDs))
+	for _, ticketID := range req.TicketIDs {
+		ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+		if err != nil {
+			return err
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	moved, err := s.sRepo.AddSprintTickets(ctx, projectID, id, req.TicketIDs)
+	if err != nil {
+		return err
+	}
+
+	var activities []*domain.TicketActivity
+	for _, ticket := range tickets {
+		from, ok := moved[ticket.ID]
+		if !ok {
+			continue
+		}
+		// A ticket listed twice is logged once
+		delete(moved, ticket.ID)
+		activities = append(activities, newActivity(ticket, userID, domain.ActivityActionUpdated, "sprint_id", formatID(from), formatID(&id)))
+	}
+
+	return s.aRepo.CreateActivities(ctx, activities)
+}
+
+// RemoveSprintTicket moves a ticket of the sprint back to the backlog
+func (s *SprintService) RemoveSprintTicket(ctx *fiber.Ctx, projectID uint, id uint, ticketID uint) error {
+	sprint, err := s.sRepo.GetSprintByID(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+	if sprint.State == domain.SprintStateCompleted {
+		return domain.ErrSprintCompleted
+	}
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+	if ticket.SprintID == nil || *ticket.SprintID != id {
+		return errors.New("ticket is not in this sprint")
+	}
+
+	if err := s.sRepo.SetTicketSprint(ctx, projectID, ticket.ID, nil); err != nil {
+		return err
+	}
+
+	activity := newActivity(ticket, ctx.Locals("user_id").(uint), domain.ActivityActionUpdated, "sprint_id", formatID(&id), nil)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+func checkSprintDates(startDate *time.Time, endDate *time.Time) error {
+	if startDate != nil && endDate != nil && !endDate.After(*startDate) {
+		return errors.New("end_date must be after start_date")
+	}
+	return nil
+}
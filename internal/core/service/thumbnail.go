@@ -0,0 +1,202 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // registers GIF with image.Decode
+	"image/jpeg"
+	"image/png"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// thumbnailSizes lists the generated thumbnails from the largest to the
+// smallest, each one is scaled down from the previous one.
+var thumbnailSizes = []struct {
+	name    string
+	maxSide int
+}{
+	{domain.ThumbnailSizeMedium, 800},
+	{domain.ThumbnailSizeSmall, 200},
+}
+
+const (
+	// maxThumbnailPixels protects against decompression bombs, a tiny file can
+	// declare a huge image.
+	maxThumbnailPixels = 20_000_000
+	// maxThumbnailSourceSize is the largest file read back from storage to
+	// generate thumbnails of a resumable upload.
+	maxThumbnailSourceSize = 50 << 20
+)
+
+// thumbnailMimeTypes are the detected content types thumbnails are made for
+var thumbnailMimeTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+}
+
+type thumbnailer struct {
+	storage port.FileStorage
+	quota   storageQuota
+}
+
+// generate stores the thumbnails of an image next to the original and records
+// them with the image dimensions on the attachment. Files that are not images
+// or cannot be decoded are left without thumbnails, it is not an error for the
+// upload. Thumbnails count toward the storage quota, an image whose thumbnails
+// do not fit is kept without them.
+func (t thumbnailer) generate(ctx *fiber.Ctx, attachment *domain.Attachment, content []byte) error {
+	if !thumbnailMimeTypes[attachment.MimeType] {
+		return nil
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil || config.Width == 0 || config.Height == 0 || config.Width*config.Height > maxThumbnailPixels {
+		return nil
+	}
+
+	// Only the first frame of an animated GIF is used
+	src, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	attachment.Width = &width
+	attachment.Height = &height
+
+	// JPEG has no alpha channel, the other formats keep their transparency as PNG
+	mimeType := "image/png"
+	if attachment.MimeType == "image/jpeg" {
+		mimeType = "image/jpeg"
+	}
+
+	img := toRGBA(src)
+	for _, size := range thumbnailSizes {
+		img = downscale(img, size.maxSide)
+
+		var buf bytes.Buffer
+		if mimeType == "image/jpeg" {
+			err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85})
+		} else {
+			err = png.Encode(&buf, img)
+		}
+		if err != nil {
+			t.delete(ctx.UserContext(), attachment.Thumbnails)
+			return err
+		}
+
+		thumbnail := &domain.AttachmentThumbnail{
+			Size:     size.name,
+			FilePath: fmt.Sprintf("%s_%s", attachment.FilePath, size.name),
+			FileSize: int64(buf.Len()),
+			MimeType: mimeType,
+			Width:    img.Bounds().Dx(),
+			Height:   img.Bounds().Dy(),
+		}
+		if err := t.storage.Put(ctx.UserContext(), thumbnail.FilePath, &buf, thumbnail.FileSize, mimeType); err != nil {
+			t.delete(ctx.UserContext(), attachment.Thumbnails)
+			return err
+		}
+		attachment.Thumbnails = append(attachment.Thumbnails, thumbnail)
+	}
+
+	if err := t.quota.reserve(ctx, thumbnailsSize(attachment.Thumbnails)); err != nil {
+		t.delete(ctx.UserContext(), attachment.Thumbnails)
+		attachment.Thumbnails = nil
+
+		var limitErr *domain.EntitlementError
+		if errors.As(err, &limitErr) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// remove deletes stored thumbnails and releases their storage
+func (t thumbnailer) remove(ctx *fiber.Ctx, thumbnails []*domain.AttachmentThumbnail) error {
+	if len(thumbnails) == 0 {
+		return nil
+	}
+	t.delete(ctx.UserContext(), thumbnails)
+	return t.quota.release(ctx, thumbnailsSize(thumbnails))
+}
+
+// delete removes stored thumbnails, failures only leave orphaned files behind.
+func (t thumbnailer) delete(ctx context.Context, thumbnails []*domain.AttachmentThumbnail) {
+	for _, thumbnail := range thumbnails {
+		t.storage.Delete(ctx, thumbnail.FilePath)
+	}
+}
+
+func thumbnailsSize(thumbnails []*domain.AttachmentThumbnail) int64 {
+	var size int64
+	for _, thumbnail := range thumbnails {
+		size += thumbnail.FileSize
+	}
+	return size
+}
+
+func toRGBA(src image.Image) *image.RGBA {
+	if img, ok := src.(*image.RGBA); ok && img.Bounds().Min == (image.Point{}) {
+		return img
+	}
+	bounds := src.Bounds()
+	img := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(img, img.Bounds(), src, bounds.Min, draw.Src)
+	return img
+}
+
+// downscale fits the image in a maxSide square keeping its aspect ratio. Each
+// target pixel is the average of the source pixels it covers, images that
+// already fit are returned as is.
+func downscale(src *image.RGBA, maxSide int) *image.RGBA {
+	srcW, srcH := src.Bounds().Dx(), src.Bounds().Dy()
+	if srcW <= maxSide && srcH <= maxSide {
+		return src
+	}
+
+	dstW, dstH := maxSide, maxSide
+	if srcW > srcH {
+		dstH = max(srcH*maxSide/srcW, 1)
+	} else {
+		dstW = max(srcW*maxSide/srcH, 1)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		y0, y1 := y*srcH/dstH, max((y+1)*srcH/dstH, y*srcH/dstH+1)
+		for x := 0; x < dstW; x++ {
+			x0, x1 := x*srcW/dstW, max((x+1)*srcW/dstW, x*srcW/dstW+1)
+
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride+x0*4 : sy*src.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					r += int(row[i])
+					g += int(row[i+1])
+					b += int(row[i+2])
+					a += int(row[i+3])
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
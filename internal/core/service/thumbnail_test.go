@@ -0,0 +1,105 @@
+package service
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestDownscale(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		maxSide       int
+		wantW, wantH  int
+	}{
+		{name: "landscape", width: 100, height: 50, maxSide: 32, wantW: 32, wantH: 16},
+		{name: "portrait", width: 50, height: 100, maxSide: 32, wantW: 16, wantH: 32},
+		{name: "square", width: 64, height: 64, maxSide: 32, wantW: 32, wantH: 32},
+		{name: "odd ratio", width: 300, height: 200, maxSide: 32, wantW: 32, wantH: 21},
+		{name: "thin landscape", width: 1000, height: 1, maxSide: 32, wantW: 32, wantH: 1},
+		{name: "thin portrait", width: 1, height: 1000, maxSide: 32, wantW: 1, wantH: 32},
+		{name: "already fits", width: 30, height: 20, maxSide: 32, wantW: 30, wantH: 20},
+		{name: "exact fit", width: 32, height: 10, maxSide: 32, wantW: 32, wantH: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := image.NewRGBA(image.Rect(0, 0, tt.width, tt.height))
+			dst := downscale(src, tt.maxSide)
+			if dst.Bounds().Dx() != tt.wantW || dst.Bounds().Dy() != tt.wantH {
+				t.Fatalf("downscale(%dx%d, %d) = %dx%d, want %dx%d", tt.width, tt.height, tt.maxSide, dst.Bounds().Dx(), dst.Bounds().Dy(), tt.wantW, tt.wantH)
+			}
+			if tt.width <= tt.maxSide && tt.height <= tt.maxSide && dst != src {
+				t.Fatal("downscale() copied an image that already fits")
+			}
+		})
+	}
+}
+
+func TestDownscaleAverages(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+
+	tests := []struct {
+		name  string
+		pixel func(x, y int) color.RGBA
+		at    image.Point
+		want  color.RGBA
+	}{
+		{
+			name: "checkerboard",
+			pixel: func(x, y int) color.RGBA {
+				if (x+y)%2 == 0 {
+					return color.RGBA{R: 255, G: 255, B: 255, A: 255}
+				}
+				return color.RGBA{A: 255}
+			},
+			at:   image.Pt(5, 5),
+			want: color.RGBA{R: 127, G: 127, B: 127, A: 255},
+		},
+		{
+			name: "left half",
+			pixel: func(x, y int) color.RGBA {
+				if x < 32 {
+					return red
+				}
+				return blue
+			},
+			at:   image.Pt(15, 3),
+			want: red,
+		},
+		{
+			name: "right half",
+			pixel: func(x, y int) color.RGBA {
+				if x < 32 {
+					return red
+				}
+				return blue
+			},
+			at:   image.Pt(16, 3),
+			want: blue,
+		},
+		{
+			name:  "transparent",
+			pixel: func(x, y int) color.RGBA { return color.RGBA{} },
+			at:    image.Pt(0, 0),
+			want:  color.RGBA{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := image.NewRGBA(image.Rect(0, 0, 64, 32))
+			for y := 0; y < 32; y++ {
+				for x := 0; x < 64; x++ {
+					src.SetRGBA(x, y, tt.pixel(x, y))
+				}
+			}
+
+			if got := downscale(src, 32).RGBAAt(tt.at.X, tt.at.Y); got != tt.want {
+				t.Fatalf("pixel %v = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,655 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+	"task-management/internal/util"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type TicketService struct {
+	tRepo    port.TicketRepository
+	wRepo    port.WorkflowRepository
+	aRepo    port.ActivityRepository
+	mRepo    port.MentionRepository
+	pRepo    port.ProjectRepository
+	coRepo   port.ComponentRepository
+	lRepo    port.TicketLinkRepository
+	watchers *autoWatcher
+	mentions *mentionRecorder
+}
+
+func NewTicketService(tRepo port.TicketRepository, wRepo port.WorkflowRepository, aRepo port.ActivityRepository, mRepo port.MentionRepository, watcherRepo port.WatcherRepository, pRepo port.ProjectRepository, coRepo port.ComponentRepository, lRepo port.TicketLinkRepository) *TicketService {
+	watchers := &autoWatcher{wRepo: watcherRepo, aRepo: aRepo}
+	return &TicketService{
+		tRepo:    tRepo,
+		wRepo:    wRepo,
+		aRepo:    aRepo,
+		mRepo:    mRepo,
+		pRepo:    pRepo,
+		coRepo:   coRepo,
+		lRepo:    lRepo,
+		watchers: watchers,
+		mentions: &mentionRecorder{mRepo: mRepo, watchers: watchers},
+	}
+}
+
+func (s *TicketService) GetTickets(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Ticket, error) {
+	return s.tRepo.GetTickets(ctx, projectID)
+}
+
+func (s *TicketService) GetTicketByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Ticket, error) {
+	return s.tRepo.GetTicketByID(ctx, projectID, id)
+}
+
+// BrowseTicket resolves a ticket key (e.g. WEB-42) inside the current organization
+// without knowing the project ID.
+func (s *TicketService) BrowseTicket(ctx *fiber.Ctx, key string) (*domain.Ticket, error) {
+	ticket, err := s.tRepo.GetTicketByKey(ctx, strings.ToUpper(key))
+	if err != nil {
+		return nil, err
+	}
+
+	canView, err := canViewProject(ctx, s.tRepo, ticket.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		// Hide tickets of projects the user cannot see
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return ticket, nil
+}
+
+// canViewProject tells if the user sees the project, either through the
+// organization role or as a project member.
+func canViewProject(ctx *fiber.Ctx, tRepo port.TicketRepository, projectID uint) (bool, error) {
+	role, ok := ctx.Locals("user_role").(*domain.OrganizationMemberRole)
+	if ok && (role.CanViewAllProjects || role.CanManageProjects) {
+		return true, nil
+	}
+	return tRepo.IsProjectMember(ctx, projectID, ctx.Locals("user_id").(uint))
+}
+
+func (s *TicketService) CreateTicket(ctx *fiber.Ctx, projectID uint, req *domain.CreateTicketRequest) (*domain.Ticket, error) {
+	userID := ctx.Locals("user_id").(uint)
+
+	status, err := s.tRepo.GetDefaultTicketStatus(ctx, projectID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.New("project has no ticket statuses")
+		}
+		return nil, err
+	}
+
+	ticket := &domain.Ticket{
+		ProjectID:      projectID,
+		Title:          req.Title,
+		Description:    req.Description,
+		TypeID:         req.TypeID,
+		StatusID:       status.ID,
+		PriorityID:     req.PriorityID,
+		AssigneeID:     req.AssigneeID,
+		ReporterID:     userID,
+		ParentID:       req.ParentID,
+		EstimatedHours: req.EstimatedHours,
+		DueDate:        req.DueDate,
+		StoryPoints:    req.StoryPoints,
+	}
+	if ticket.TypeID == 0 {
+		ticket.TypeID = domain.DefaultTicketTypeID
+	}
+	if ticket.PriorityID == 0 {
+		ticket.PriorityID = domain.DefaultPriorityID
+	}
+
+	if err := s.validateReferences(ctx, projectID, &ticket.TypeID, &ticket.PriorityID, ticket.AssigneeID); err != nil {
+		return nil, err
+	}
+	if ticket.ParentID != nil {
+		ticketType, err := s.tRepo.GetTicketTypeByID(ctx, ticket.TypeID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkHierarchy(ctx, projectID, 0, ticketType, ticket.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	components, err := s.ticketComponents(ctx, projectID, req.ComponentIDs)
+	if err != nil {
+		return nil, err
+	}
+	ticket.Components = components
+
+	autoAssigned := false
+	if ticket.AssigneeID == nil {
+		assigneeID, err := s.autoAssignee(ctx, projectID, components)
+		if err != nil {
+			return nil, err
+		}
+		ticket.AssigneeID = assigneeID
+		autoAssigned = assigneeID != nil
+	}
+
+	if err := s.tRepo.CreateTicket(ctx, ticket); err != nil {
+		return nil, err
+	}
+
+	activities := []*domain.TicketActivity{
+		newActivity(ticket, userID, domain.ActivityActionCreated, "", nil, &ticket.TicketKey),
+	}
+	if autoAssigned {
+		activities = append(activities, newActivity(ticket, userID, domain.ActivityActionAutoAssigned, "assignee_id", nil, formatID(ticket.AssigneeID)))
+	}
+	if err := s.aRepo.CreateActivities(ctx, activities); err != nil {
+		return nil, err
+	}
+
+	watcherIDs := []uint{ticket.ReporterID}
+	if ticket.AssigneeID != nil {
+		watcherIDs = append(watcherIDs, *ticket.AssigneeID)
+	}
+	if err := s.watchers.add(ctx, ticket, watcherIDs...); err != nil {
+		return nil, err
+	}
+
+	if err := s.mentions.record(ctx, ticket, nil, ticket.Description); err != nil {
+		return nil, err
+	}
+
+	return s.tRepo.GetTicketByID(ctx, projectID, ticket.ID)
+}
+
+func (s *TicketService) UpdateTicket(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateTicketRequest) (*domain.Ticket, error) {
+	before, err := s.tRepo.GetTicketByID(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateReferences(ctx, projectID, req.TypeID, req.PriorityID, req.AssigneeID); err != nil {
+		return nil, err
+	}
+	if req.TypeID != nil || req.ParentID != nil {
+		ticketType, parentID := before.Type, before.ParentID
+		if req.TypeID != nil {
+			if ticketType, err = s.tRepo.GetTicketTypeByID(ctx, *req.TypeID); err != nil {
+				return nil, err
+			}
+		}
+		if req.ParentID != nil {
+			parentID = req.ParentID
+		}
+		if err := s.checkHierarchy(ctx, projectID, id, ticketType, parentID); err != nil {
+			return nil, err
+		}
+	}
+
+	after, err := s.tRepo.UpdateTicket(ctx, projectID, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.aRepo.CreateActivities(ctx, ticketChanges(before, after, ctx.Locals("user_id").(uint))); err != nil {
+		return nil, err
+	}
+
+	if after.AssigneeID != nil && !equalValues(formatID(before.AssigneeID), formatID(after.AssigneeID)) {
+		if err := s.watchers.add(ctx, after, *after.AssigneeID); err != nil {
+			return nil, err
+		}
+	}
+
+	if after.Description != before.Description {
+		if err := s.mentions.record(ctx, after, nil, after.Description); err != nil {
+			return nil, err
+		}
+	}
+
+	return after, nil
+}
+
+// GetAvailableTransitions lists the transitions out of the ticket's current status.
+// Projects without a workflow allow moving to any other active status.
+func (s *TicketService) GetAvailableTransitions(ctx *fiber.Ctx, projectID uint, id uint) ([]*domain.WorkflowTransition, error) {
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := s.wRepo.GetTransitionsForType(ctx, projectID, ticket.TypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	available := []*domain.WorkflowTransition{}
+	if len(transitions) > 0 {
+		for _, transition := range transitions {
+			if transition.ToStatusID == ticket.StatusID {
+				continue
+			}
+			if transition.FromStatusID == nil || *transition.FromStatusID == ticket.StatusID {
+				available = append(available, transition)
+			}
+		}
+		return available, nil
+	}
+
+	statuses, err := s.tRepo.GetTicketStatuses(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range statuses {
+		if status.ID == ticket.StatusID || !status.IsActive {
+			continue
+		}
+		available = append(available, &domain.WorkflowTransition{
+			ProjectID:  projectID,
+			ToStatusID: status.ID,
+			Name:       status.Name,
+			ToStatus:   status,
+		})
+	}
+	return available, nil
+}
+
+// TransitionTicket moves a ticket to another status, enforcing the project workflow
+// and keeping the resolution fields in sync with the target status. Going over
+// the WIP limit of a board column fails in enforce mode and returns a warning
+// in warn mode. Closing a ticket that still blocks open tickets is allowed
+// with a warning.
+func (s *TicketService) TransitionTicket(ctx *fiber.Ctx, projectID uint, id uint, req *domain.TransitionTicketRequest) (*domain.TransitionResult, error) {
+	userID := ctx.Locals("user_id").(uint)
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ticket.StatusID == req.ToStatusID {
+		return nil, errors.New("ticket is already in this status")
+	}
+
+	toStatus, err := s.tRepo.GetTicketStatusByID(ctx, projectID, req.ToStatusID)
+	if err != nil || !toStatus.IsActive {
+		return nil, errors.New("status does not belong to this project")
+	}
+
+	transitions, err := s.wRepo.GetTransitionsForType(ctx, projectID, ticket.TypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(transitions) > 0 {
+		var transition *domain.WorkflowTransition
+		for _, t := range transitions {
+			if t.ToStatusID == req.ToStatusID && (t.FromStatusID == nil || *t.FromStatusID == ticket.StatusID) {
+				transition = t
+				break
+			}
+		}
+		if transition == nil {
+			return nil, domain.ErrTransitionNotAllowed
+		}
+
+		if err := s.checkTransitionGuards(ctx, transition, ticket, req); err != nil {
+			return nil, err
+		}
+	}
+
+	warnings := []string{}
+	if toStatus.IsClosed {
+		blocked, err := s.lRepo.GetOpenBlockedTickets(ctx, ticket.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(blocked) > 0 {
+			keys := make([]string, len(blocked))
+			for i, t := range blocked {
+				keys[i] = t.TicketKey
+			}
+			warnings = append(warnings, fmt.Sprintf("%s still blocks open tickets: %s", ticket.TicketKey, strings.Join(keys, ", ")))
+		}
+	}
+
+	now := time.Now()
+	change := &domain.TicketStatusChange{StatusID: toStatus.ID}
+	history := &domain.TicketStatusHistory{
+		TicketID:     ticket.ID,
+		ProjectID:    projectID,
+		FromStatusID: &ticket.StatusID,
+		ToStatusID:   toStatus.ID,
+		ChangedBy:    userID,
+		ChangedAt:    now,
+	}
+
+	if toStatus.IsResolved {
+		resolutionID := req.ResolutionID
+		if resolutionID != nil {
+			if _, err := s.wRepo.GetResolutionByID(ctx, *resolutionID); err != nil {
+				return nil, errors.New("resolution not found")
+			}
+		} else if resolution, err := s.wRepo.GetDefaultResolution(ctx); err == nil {
+			resolutionID = &resolution.ID
+		}
+
+		change.ResolutionID = resolutionID
+		change.ResolvedAt = &now
+		change.ResolvedBy = &userID
+	}
+
+	var wipWarnings []string
+	after, err := s.tRepo.TransitionTicket(ctx, projectID, id, change, history, func(columns []*domain.ColumnWIP) error {
+		var err error
+		wipWarnings, err = checkWIPLimits(columns)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(wipWarnings, warnings...)
+
+	activities := []*domain.TicketActivity{
+		newActivity(after, userID, domain.ActivityActionTransitioned, "status_id", formatID(&ticket.StatusID), formatID(&after.StatusID)),
+	}
+	activities = append(activities, ticketChanges(ticket, after, userID)...)
+	if err := s.aRepo.CreateActivities(ctx, activities); err != nil {
+		return nil, err
+	}
+
+	return &domain.TransitionResult{Ticket: after, Warnings: warnings}, nil
+}
+
+// checkWIPLimits looks at the limited board columns the ticket enters, moving
+// between two statuses of the same column does not change its load.
+func checkWIPLimits(columns []*domain.ColumnWIP) ([]string, error) {
+	warnings := []string{}
+	for _, column := range columns {
+		if column.TicketCount < column.WIPLimit {
+			continue
+		}
+		if column.WIPMode == domain.WIPModeEnforce {
+			return nil, fmt.Errorf("%w: %s on board %s allows %d tickets", domain.ErrWIPLimitReached, column.ColumnName, column.BoardName, column.WIPLimit)
+		}
+		warnings = append(warnings, fmt.Sprintf("%s on board %s is over its WIP limit (%d/%d)", column.ColumnName, column.BoardName, column.TicketCount+1, column.WIPLimit))
+	}
+	return warnings, nil
+}
+
+func (s *TicketService) GetStatusHistory(ctx *fiber.Ctx, projectID uint, ticketID uint) (int64, int64, int64, []*domain.TicketStatusHistory, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return 0, 0, 0, nil, err
+	}
+	return s.tRepo.GetStatusHistory(ctx, projectID, ticketID)
+}
+
+func (s *TicketService) GetProjectStatusHistory(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.TicketStatusHistory, error) {
+	return s.tRepo.GetProjectStatusHistory(ctx, projectID)
+}
+
+func (s *TicketService) GetMentions(ctx *fiber.Ctx, projectID uint, ticketID uint) ([]*domain.TicketMention, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return nil, err
+	}
+	return s.mRepo.GetMentions(ctx, ticketID)
+}
+
+func (s *TicketService) checkTransitionGuards(ctx *fiber.Ctx, transition *domain.WorkflowTransition, ticket *domain.Ticket, req *domain.TransitionTicketRequest) error {
+	if transition.RequireManageTasks {
+		role, ok := ctx.Locals("project_role").(*domain.ProjectMemberRole)
+		if !ok || !role.CanManageTasks {
+			return domain.ErrForbidden
+		}
+	}
+
+	if transition.RequireAssignee && ticket.AssigneeID == nil {
+		return errors.New("ticket must be assigned before this transition")
+	}
+
+	if transition.RequireResolution && req.ResolutionID == nil {
+		return errors.New("resolution_id is required for this transition")
+	}
+
+	return nil
+}
+
+func (s *TicketService) DeleteTicket(ctx *fiber.Ctx, projectID uint, id uint) error {
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.tRepo.DeleteTicket(ctx, projectID, id); err != nil {
+		return err
+	}
+
+	activity := newActivity(ticket, ctx.Locals("user_id").(uint), domain.ActivityActionDeleted, "", &ticket.TicketKey, nil)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+// RankTicket moves a ticket between two neighbours of the backlog or of a board
+// column, only the moved ticket gets a new rank. Ranks that cannot be placed
+// between, because they are duplicated or too long, are rebalanced first.
+func (s *TicketService) RankTicket(ctx *fiber.Ctx, projectID uint, id uint, req *domain.RankTicketRequest) (*domain.Ticket, error) {
+	if req.BeforeID == nil && req.AfterID == nil {
+		return nil, errors.New("before_id or after_id is required")
+	}
+	if (req.BeforeID != nil && *req.BeforeID == id) || (req.AfterID != nil && *req.AfterID == id) {
+		return nil, errors.New("a ticket cannot be ranked relative to itself")
+	}
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		prev, next, err := s.rankNeighbours(ctx, projectID, ticket.ID, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if prev == "" || next == "" || prev < next {
+			rank := util.RankBetween(prev, next)
+			if len(rank) <= maxRankLength || attempt > 0 {
+				if err := s.tRepo.UpdateTicketRank(ctx, projectID, ticket.ID, rank); err != nil {
+					return nil, err
+				}
+				return s.tRepo.GetTicketByID(ctx, projectID, ticket.ID)
+			}
+		} else if attempt > 0 {
+			return nil, errors.New("after_id must be ranked before before_id")
+		}
+
+		if err := s.tRepo.RebalanceRanks(ctx.UserContext(), projectID); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rankNeighbours returns the ranks the ticket goes between, the missing
+// neighbour is the closest ticket on the other side of the given one.
+func (s *TicketService) rankNeighbours(ctx *fiber.Ctx, projectID uint, id uint, req *domain.RankTicketRequest) (string, string, error) {
+	var prev, next string
+	if req.AfterID != nil {
+		after, err := s.tRepo.GetTicketByID(ctx, projectID, *req.AfterID)
+		if err != nil {
+			return "", "", errors.New("after_id ticket not found in this project")
+		}
+		prev = after.Rank
+	}
+	if req.BeforeID != nil {
+		before, err := s.tRepo.GetTicketByID(ctx, projectID, *req.BeforeID)
+		if err != nil {
+			return "", "", errors.New("before_id ticket not found in this project")
+		}
+		next = before.Rank
+	}
+
+	var err error
+	switch {
+	case req.AfterID == nil:
+		prev, err = s.tRepo.GetNeighbourRank(ctx, projectID, next, true, id)
+	case req.BeforeID == nil:
+		next, err = s.tRepo.GetNeighbourRank(ctx, projectID, prev, false, id)
+	}
+	return prev, next, err
+}
+
+func (s *TicketService) GetTicketStatuses(ctx *fiber.Ctx, projectID uint) ([]*domain.TicketStatus, error) {
+	return s.tRepo.GetTicketStatuses(ctx, projectID)
+}
+
+func (s *TicketService) GetTicketTypes(ctx *fiber.Ctx) ([]*domain.TicketType, error) {
+	return s.tRepo.GetTicketTypes(ctx)
+}
+
+func (s *TicketService) GetPriorities(ctx *fiber.Ctx) ([]*domain.Priority, error) {
+	return s.tRepo.GetPriorities(ctx)
+}
+
+// validateReferences checks the foreign keys of a ticket. A nil value means the
+// field is not being set, and a zero assignee clears the field.
+func (s *TicketService) validateReferences(ctx *fiber.Ctx, projectID uint, typeID *uint, priorityID *uint, assigneeID *uint) error {
+	if typeID != nil {
+		if _, err := s.tRepo.GetTicketTypeByID(ctx, *typeID); err != nil {
+			return errors.New("ticket type not found")
+		}
+	}
+
+	if priorityID != nil {
+		if _, err := s.tRepo.GetPriorityByID(ctx, *priorityID); err != nil {
+			return errors.New("priority not found")
+		}
+	}
+
+	if assigneeID != nil && *assigneeID != 0 {
+		isMember, err := s.tRepo.IsProjectMember(ctx, projectID, *assigneeID)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return errors.New("assignee is not a member of this project")
+		}
+	}
+
+	return nil
+}
+
+// checkHierarchy keeps the parent of a ticket exactly one type level above it
+// (epic > story > subtask) and its children one level below. A parent that is
+// a descendant of the ticket would create a cycle. A zero parent clears it.
+func (s *TicketService) checkHierarchy(ctx *fiber.Ctx, projectID uint, ticketID uint, ticketType *domain.TicketType, parentID *uint) error {
+	if parentID != nil && *parentID != 0 {
+		if *parentID == ticketID {
+			return errors.New("a ticket cannot be its own parent")
+		}
+		parent, err := s.tRepo.GetTicketByID(ctx, projectID, *parentID)
+		if err != nil {
+			return errors.New("parent ticket not found in this project")
+		}
+		if parent.Type == nil || parent.Type.HierarchyLevel != ticketType.HierarchyLevel+1 {
+			return fmt.Errorf("%s %s cannot be the parent of a %s", parent.TicketKey, typeName(parent.Type), ticketType.Name)
+		}
+
+		if ticketID != 0 {
+			ancestors, err := s.tRepo.GetAncestorIDs(ctx, parent.ID)
+			if err != nil {
+				return err
+			}
+			for _, ancestorID := range ancestors {
+				if ancestorID == ticketID {
+					return errors.New("the parent cannot be a descendant of the ticket")
+				}
+			}
+		}
+	}
+
+	if ticketID != 0 {
+		children, err := s.tRepo.GetChildren(ctx, projectID, ticketID)
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			if child.Type == nil || child.Type.HierarchyLevel != ticketType.HierarchyLevel-1 {
+				return fmt.Errorf("a %s cannot be the parent of %s %s", ticketType.Name, child.TicketKey, typeName(child.Type))
+			}
+		}
+	}
+
+	return nil
+}
+
+func typeName(ticketType *domain.TicketType) string {
+	if ticketType == nil {
+		return "ticket"
+	}
+	return ticketType.Name
+}
+
+// ticketComponents loads the components of a new ticket in the requested order,
+// every one must be an active component of the project.
+func (s *TicketService) ticketComponents(ctx *fiber.Ctx, projectID uint, ids []uint) ([]*domain.Component, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	found, err := s.coRepo.GetComponentsByIDs(ctx, projectID, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]*domain.Component, len(found))
+	for _, component := range found {
+		byID[component.ID] = component
+	}
+
+	var components []*domain.Component
+	seen := map[uint]bool{}
+	for _, id := range ids {
+		component, ok := byID[id]
+		if !ok || !component.IsActive {
+			return nil, errors.New("component not found in this project")
+		}
+		if !seen[id] {
+			seen[id] = true
+			components = append(components, component)
+		}
+	}
+	return components, nil
+}
+
+// autoAssignee picks the assignee of an unassigned ticket from the project
+// auto-assign mode. It returns nil when nobody qualifies, e.g. the first
+// component has no lead or the lead has left the project.
+func (s *TicketService) autoAssignee(ctx *fiber.Ctx, projectID uint, components []*domain.Component) (*uint, error) {
+	project, err := s.pRepo.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidate *uint
+	switch project.AutoAssignMode {
+	case domain.AutoAssignComponentLead:
+		if len(components) > 0 {
+			candidate = components[0].LeadID
+		}
+	case domain.AutoAssignProjectOwner:
+		candidate = &project.OwnerID
+	}
+	if candidate == nil {
+		return nil, nil
+	}
+
+	isMember, err := s.tRepo.IsProjectMember(ctx, projectID, *candidate)
+	if err != nil || !isMember {
+		return nil, err
+	}
+	return candidate, nil
+}
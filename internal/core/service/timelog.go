@@ -0,0 +1,221 @@
+package service
+
+import (
+	"errors"
+	"math"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type TimeLogService struct {
+	tlRepo port.TimeLogRepository
+	tRepo  port.TicketRepository
+	aRepo  port.ActivityRepository
+}
+
+func NewTimeLogService(tlRepo port.TimeLogRepository, tRepo port.TicketRepository, aRepo port.ActivityRepository) *TimeLogService {
+	return &TimeLogService{tlRepo: tlRepo, tRepo: tRepo, aRepo: aRepo}
+}
+
+func (s *TimeLogService) GetTimeLogs(ctx *fiber.Ctx, projectID uint, ticketID uint) (int64, int64, int64, []*domain.TimeLog, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return 0, 0, 0, nil, err
+	}
+	return s.tlRepo.GetTimeLogs(ctx, ticketID)
+}
+
+func (s *TimeLogService) CreateTimeLog(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.CreateTimeLogRequest) (*domain.TimeLog, error) {
+	userID := ctx.Locals("user_id").(uint)
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	log := &domain.TimeLog{
+		TicketID:    ticket.ID,
+		UserID:      userID,
+		Hours:       req.Hours,
+		Description: req.Description,
+		LoggedDate:  time.Now(),
+	}
+	if req.LoggedDate != nil {
+		log.LoggedDate = *req.LoggedDate
+	}
+
+	if err := s.tlRepo.CreateTimeLog(ctx, log); err != nil {
+		return nil, err
+	}
+
+	activity := newActivity(ticket, userID, domain.ActivityActionAdded, "time_log", nil, formatFloat(&log.Hours))
+	if err := s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity}); err != nil {
+		return nil, err
+	}
+
+	return s.tlRepo.GetTimeLogByID(ctx, ticket.ID, log.ID)
+}
+
+// UpdateTimeLog changes a time log. Users can change their own logs, project
+// members with CanManageTasks can change any log.
+func (s *TimeLogService) UpdateTimeLog(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint, req *domain.UpdateTimeLogRequest) (*domain.TimeLog, error) {
+	ticket, before, err := s.ownTimeLog(ctx, projectID, ticketID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := s.tlRepo.UpdateTimeLog(ctx, ticket.ID, before.ID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if after.Hours != before.Hours {
+		activity := newActivity(ticket, ctx.Locals("user_id").(uint), domain.ActivityActionUpdated, "time_log", formatFloat(&before.Hours), formatFloat(&after.Hours))
+		if err := s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity}); err != nil {
+			return nil, err
+		}
+	}
+
+	return after, nil
+}
+
+func (s *TimeLogService) DeleteTimeLog(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) error {
+	ticket, log, err := s.ownTimeLog(ctx, projectID, ticketID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.tlRepo.DeleteTimeLog(ctx, ticket.ID, log.ID); err != nil {
+		return err
+	}
+
+	activity := newActivity(ticket, ctx.Locals("user_id").(uint), domain.ActivityActionRemoved, "time_log", formatFloat(&log.Hours), nil)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+func (s *TimeLogService) GetMyTimer(ctx *fiber.Ctx) (*domain.Timer, error) {
+	return s.tlRepo.GetTimer(ctx, ctx.Locals("user_id").(uint))
+}
+
+// StartTimer starts the timer of the user on the ticket, a user can only have
+// one running timer.
+func (s *TimeLogService) StartTimer(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.StartTimerRequest) (*domain.Timer, error) {
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	timer := &domain.Timer{
+		UserID:      ctx.Locals("user_id").(uint),
+		TicketID:    ticket.ID,
+		Description: req.Description,
+		StartedAt:   time.Now(),
+	}
+	if err := s.tlRepo.StartTimer(ctx, timer); err != nil {
+		return nil, err
+	}
+
+	timer.Ticket = ticket
+	return timer, nil
+}
+
+// StopTimer logs the time since the timer started, rounded up to the minute.
+// Timers that ran longer than a log can hold must be discarded.
+func (s *TimeLogService) StopTimer(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.StopTimerRequest) (*domain.TimeLog, error) {
+	userID := ctx.Locals("user_id").(uint)
+
+	ticket, timer, err := s.ticketTimer(ctx, projectID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	hours := timerHours(time.Since(timer.StartedAt))
+	if hours > domain.MaxTimeLogHours {
+		return nil, errors.New("the timer ran for more than 24 hours, discard it and log the time manually")
+	}
+
+	log := &domain.TimeLog{
+		TicketID:    ticket.ID,
+		UserID:      userID,
+		Hours:       hours,
+		Description: timer.Description,
+		LoggedDate:  timer.StartedAt,
+	}
+	if req.Description != nil {
+		log.Description = *req.Description
+	}
+
+	if err := s.tlRepo.StopTimer(ctx, timer.ID, log); err != nil {
+		return nil, err
+	}
+
+	activity := newActivity(ticket, userID, domain.ActivityActionAdded, "time_log", nil, formatFloat(&log.Hours))
+	if err := s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity}); err != nil {
+		return nil, err
+	}
+
+	return s.tlRepo.GetTimeLogByID(ctx, ticket.ID, log.ID)
+}
+
+func (s *TimeLogService) DiscardTimer(ctx *fiber.Ctx, projectID uint, ticketID uint) error {
+	_, timer, err := s.ticketTimer(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+	return s.tlRepo.DeleteTimer(ctx, timer.ID)
+}
+
+// ticketTimer returns the running timer of the user, which must be on the ticket
+func (s *TimeLogService) ticketTimer(ctx *fiber.Ctx, projectID uint, ticketID uint) (*domain.Ticket, *domain.Timer, error) {
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	timer, err := s.tlRepo.GetTimer(ctx, ctx.Locals("user_id").(uint))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, domain.ErrTimerNotRunning
+		}
+		return nil, nil, err
+	}
+	if timer.TicketID != ticket.ID {
+		return nil, nil, domain.ErrTimerNotRunning
+	}
+
+	return ticket, timer, nil
+}
+
+// ownTimeLog loads a time log the user is allowed to change
+func (s *TimeLogService) ownTimeLog(ctx *fiber.Ctx, projectID uint, ticketID uint, id uint) (*domain.Ticket, *domain.TimeLog, error) {
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log, err := s.tlRepo.GetTimeLogByID(ctx, ticket.ID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if log.UserID != ctx.Locals("user_id").(uint) && !canManageTimeLogs(ctx) {
+		return nil, nil, domain.ErrForbidden
+	}
+
+	return ticket, log, nil
+}
+
+func canManageTimeLogs(ctx *fiber.Ctx) bool {
+	role, ok := ctx.Locals("project_role").(*domain.ProjectMemberRole)
+	return ok && role.CanManageTasks
+}
+
+// timerHours converts the elapsed time to hours with two decimals, started
+// minutes count as full minutes and a timer logs at least one minute
+func timerHours(elapsed time.Duration) float64 {
+	minutes := math.Max(1, math.Ceil(elapsed.Minutes()))
+	return math.Round(minutes/60*100) / 100
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type TimesheetService struct {
+	tsRepo port.TimesheetRepository
+}
+
+func NewTimesheetService(tsRepo port.TimesheetRepository) *TimesheetService {
+	return &TimesheetService{tsRepo: tsRepo}
+}
+
+// GetTimesheets lists the submitted timesheets of the organization. Members
+// without CanManageMembers only see their own.
+func (s *TimesheetService) GetTimesheets(ctx *fiber.Ctx, userID *uint, status string) (int64, int64, int64, []*domain.Timesheet, error) {
+	switch status {
+	case "", domain.TimesheetStatusDraft, domain.TimesheetStatusSubmitted, domain.TimesheetStatusApproved, domain.TimesheetStatusRejected:
+	default:
+		return 0, 0, 0, nil, errors.New("status must be draft, submitted, approved or rejected")
+	}
+
+	if !canManageTimesheets(ctx) {
+		self := ctx.Locals("user_id").(uint)
+		if userID != nil && *userID != self {
+			return 0, 0, 0, nil, domain.ErrForbidden
+		}
+		userID = &self
+	}
+
+	return s.tsRepo.GetTimesheets(ctx, ctx.Locals("organization_id").(uint), userID, status)
+}
+
+// GetTimesheet returns the hours per ticket and day of the week of the date,
+// for the user when userID is nil. A week that was never submitted is a draft
+// without ID.
+func (s *TimesheetService) GetTimesheet(ctx *fiber.Ctx, userID *uint, week time.Time) (*domain.TimesheetView, error) {
+	organizationID := ctx.Locals("organization_id").(uint)
+	self := ctx.Locals("user_id").(uint)
+	if userID == nil {
+		userID = &self
+	} else if *userID != self && !canManageTimesheets(ctx) {
+		return nil, domain.ErrForbidden
+	}
+
+	weekStart := domain.WeekStart(week)
+
+	timesheet, err := s.tsRepo.GetTimesheetByWeek(ctx, organizationID, *userID, weekStart)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		timesheet = &domain.Timesheet{
+			OrganizationID: organizationID,
+			UserID:         *userID,
+			WeekStart:      weekStart,
+			Status:         domain.TimesheetStatusDraft,
+		}
+	}
+
+	logs, err := s.tsRepo.GetWeekTimeLogs(ctx, organizationID, *userID, weekStart)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTimesheetView(timesheet, logs), nil
+}
+
+// SubmitTimesheet submits the week of the date for the user, its time logs
+// cannot change until the timesheet is rejected
+func (s *TimesheetService) SubmitTimesheet(ctx *fiber.Ctx, week time.Time) (*domain.Timesheet, error) {
+	return s.tsRepo.SubmitTimesheet(ctx, ctx.Locals("organization_id").(uint), ctx.Locals("user_id").(uint), domain.WeekStart(week))
+}
+
+func (s *TimesheetService) ApproveTimesheet(ctx *fiber.Ctx, id uint, req *domain.ReviewTimesheetRequest) (*domain.Timesheet, error) {
+	return s.reviewTimesheet(ctx, id, domain.TimesheetStatusApproved, req)
+}
+
+// RejectTimesheet sends the timesheet back to the user with the reason, which
+// unlocks its time logs
+func (s *TimesheetService) RejectTimesheet(ctx *fiber.Ctx, id uint, req *domain.ReviewTimesheetRequest) (*domain.Timesheet, error) {
+	if strings.TrimSpace(req.Comment) == "" {
+		return nil, errors.New("a comment is required to reject a timesheet")
+	}
+	return s.reviewTimesheet(ctx, id, domain.TimesheetStatusRejected, req)
+}
+
+// reviewTimesheet approves or rejects the timesheet, users cannot review their
+// own timesheets
+func (s *TimesheetService) reviewTimesheet(ctx *fiber.Ctx, id uint, status string, req *domain.ReviewTimesheetRequest) (*domain.Timesheet, error) {
+	organizationID := ctx.Locals("organization_id").(uint)
+	reviewerID := ctx.Locals("user_id").(uint)
+
+	timesheet, err := s.tsRepo.GetTimesheetByID(ctx, organizationID, id)
+	if err != nil {
+		return nil, err
+	}
+	if timesheet.UserID == reviewerID {
+		return nil, domain.ErrForbidden
+	}
+
+	return s.tsRepo.ReviewTimesheet(ctx, organizationID, id, status, reviewerID, strings.TrimSpace(req.Comment))
+}
+
+func canManageTimesheets(ctx *fiber.Ctx) bool {
+	role, ok := ctx.Locals("user_role").(*domain.OrganizationMemberRole)
+	return ok && role.CanManageMembers
+}
+
+// newTimesheetView sums the logs per ticket and day of the week, rows are
+// ordered by ticket
+func newTimesheetView(timesheet *domain.Timesheet, logs []*domain.TimeLog) *domain.TimesheetView {
+	view := &domain.TimesheetView{
+		Timesheet:   timesheet,
+		Days:        make([]string, 7),
+		Rows:        []*domain.TimesheetRow{},
+		DailyTotals: make([]float64, 7),
+	}
+	for i := range view.Days {
+		view.Days[i] = timesheet.WeekStart.AddDate(0, 0, i).Format(time.DateOnly)
+	}
+
+	rows := map[uint]*domain.TimesheetRow{}
+	total := 0.0
+	for _, log := range logs {
+		day := int(log.LoggedDate.UTC().Sub(timesheet.WeekStart).Hours() / 24)
+		if day < 0 || day > 6 {
+			continue
+		}
+
+		row, ok := rows[log.TicketID]
+		if !ok {
+			row = &domain.TimesheetRow{TicketID: log.TicketID, Ticket: log.Ticket, Hours: make([]float64, 7)}
+			rows[log.TicketID] = row
+			view.Rows = append(view.Rows, row)
+		}
+		row.Hours[day] += log.Hours
+		row.Total += log.Hours
+		view.DailyTotals[day] += log.Hours
+		total += log.Hours
+	}
+
+	sort.Slice(view.Rows, func(i, j int) bool { return view.Rows[i].TicketID < view.Rows[j].TicketID })
+	for _, row := range view.Rows {
+		for i := range row.Hours {
+			row.Hours[i] = roundHours(row.Hours[i])
+		}
+		row.Total = roundHours(row.Total)
+	}
+	for i := range view.DailyTotals {
+		view.DailyTotals[i] = roundHours(view.DailyTotals[i])
+	}
+	timesheet.TotalHours = roundHours(total)
+
+	return view
+}
+
+// roundHours drops the float noise of sums of hours, logs have two decimals
+func roundHours(hours float64) float64 {
+	return math.Round(hours*100) / 100
+}
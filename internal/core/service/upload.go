@@ -0,0 +1,482 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// uploadTTL is how long an unfinished resumable upload can be resumed
+	uploadTTL = 24 * time.Hour
+	// uploadReapDelay lets requests that started before an upload expired
+	// finish before the reaper removes it
+	uploadReapDelay = time.Hour
+	// uploadReapBatch is how many expired uploads are loaded at a time
+	uploadReapBatch = 100
+)
+
+type UploadService struct {
+	upRepo     port.UploadRepository
+	tRepo      port.TicketRepository
+	aRepo      port.ActivityRepository
+	quota      storageQuota
+	storage    port.FileStorage
+	maxSize    int64
+	thumbnails thumbnailer
+}
+
+func NewUploadService(upRepo port.UploadRepository, tRepo port.TicketRepository, aRepo port.ActivityRepository, uRepo port.UsageRepository, storage port.FileStorage, maxSize int64) *UploadService {
+	quota := storageQuota{uRepo: uRepo}
+	return &UploadService{
+		upRepo:     upRepo,
+		tRepo:      tRepo,
+		aRepo:      aRepo,
+		quota:      quota,
+		storage:    storage,
+		maxSize:    maxSize,
+		thumbnails: thumbnailer{storage: storage, quota: quota},
+	}
+}
+
+func (s *UploadService) MaxSize() int64 {
+	return s.maxSize
+}
+
+func (s *UploadService) CreateUpload(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.CreateUploadRequest) (*domain.AttachmentUpload, error) {
+	if req.Length <= 0 {
+		return nil, errors.New("Upload-Length must be greater than 0")
+	}
+	if req.Length > s.maxSize {
+		return nil, domain.ErrFileTooLarge
+	}
+
+	if req.Checksum != "" {
+		algorithm, _, err := parseChecksum(req.Checksum)
+		if err != nil {
+			return nil, err
+		}
+		if algorithm != "sha256" {
+			return nil, errors.New("only sha256 is supported for the checksum of the whole file")
+		}
+	}
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The bytes are only reserved once the upload completes
+	if err := s.quota.check(ctx, req.Length); err != nil {
+		return nil, err
+	}
+
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return nil, err
+	}
+
+	hashState, err := sha256.New().(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	upload := &domain.AttachmentUpload{
+		UploadID:  hex.EncodeToString(random),
+		ProjectID: projectID,
+		TicketID:  ticket.ID,
+		UserID:    ctx.Locals("user_id").(uint),
+		Filename:  sanitizeFilename(req.Filename),
+		Length:    req.Length,
+		Checksum:  req.Checksum,
+		HashState: hashState,
+		ExpiresAt: time.Now().Add(uploadTTL),
+	}
+	if err := s.upRepo.CreateUpload(ctx, upload); err != nil {
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+func (s *UploadService) GetUpload(ctx *fiber.Ctx, projectID uint, ticketID uint, uploadID string) (*domain.AttachmentUpload, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return nil, err
+	}
+	return s.ownUpload(ctx, ticketID, uploadID)
+}
+
+// WriteChunk stores the chunk at the current offset. The chunk is rejected when
+// its Upload-Checksum does not match. Once all bytes are received the chunks are
+// joined into the attachment and the checksum of the whole file is verified.
+func (s *UploadService) WriteChunk(ctx *fiber.Ctx, projectID uint, ticketID uint, uploadID string, req *domain.UploadChunkRequest) (*domain.AttachmentUpload, error) {
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := s.ownUpload(ctx, ticket.ID, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if upload.CompletedAt != nil {
+		return nil, domain.ErrUploadCompleted
+	}
+	if time.Now().After(upload.ExpiresAt) {
+		return nil, domain.ErrUploadExpired
+	}
+	if req.Offset != upload.Offset {
+		return nil, domain.ErrUploadOffsetMismatch
+	}
+
+	size := int64(len(req.Content))
+	if upload.Offset+size > upload.Length {
+		return nil, errors.New("chunk exceeds Upload-Length")
+	}
+
+	fileHash := sha256.New()
+	if err := fileHash.(encoding.BinaryUnmarshaler).UnmarshalBinary(upload.HashState); err != nil {
+		return nil, err
+	}
+
+	if size == 0 {
+		// Every byte was received but completing failed, e.g. on the storage
+		// quota, an empty PATCH retries it
+		if upload.Offset == upload.Length {
+			if err := s.complete(ctx, ticket, upload, fileHash.Sum(nil)); err != nil {
+				return nil, err
+			}
+		}
+		return upload, nil
+	}
+
+	if req.Checksum != "" {
+		if err := verifyChecksum(req.Checksum, req.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	fileHash.Write(req.Content)
+	hashState, err := fileHash.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	mimeType := upload.MimeType
+	if upload.Offset == 0 {
+		mimeType = http.DetectContentType(req.Content)
+	}
+
+	// The chunk is only written once its offset is claimed, so a concurrent
+	// request for the same offset cannot overwrite it
+	key := uploadChunkKey(upload.UploadID, upload.ChunkCount)
+	store := func() error {
+		return s.storage.Put(ctx.UserContext(), key, bytes.NewReader(req.Content), size, "application/octet-stream")
+	}
+	if err := s.upRepo.AdvanceUpload(ctx, upload, size, hashState, mimeType, store); err != nil {
+		return nil, err
+	}
+
+	if upload.Offset < upload.Length {
+		return upload, nil
+	}
+
+	if err := s.complete(ctx, ticket, upload, fileHash.Sum(nil)); err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+// DeleteUpload terminates an unfinished upload and removes its chunks.
+func (s *UploadService) DeleteUpload(ctx *fiber.Ctx, projectID uint, ticketID uint, uploadID string) error {
+	upload, err := s.GetUpload(ctx, projectID, ticketID, uploadID)
+	if err != nil {
+		return err
+	}
+	if upload.CompletedAt != nil {
+		return domain.ErrUploadCompleted
+	}
+
+	return s.discard(ctx, upload)
+}
+
+// ownUpload returns an upload of the ticket, only the user who started an
+// upload can see or continue it.
+func (s *UploadService) ownUpload(ctx *fiber.Ctx, ticketID uint, uploadID string) (*domain.AttachmentUpload, error) {
+	upload, err := s.upRepo.GetUpload(ctx, ticketID, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if upload.UserID != ctx.Locals("user_id").(uint) {
+		return nil, domain.ErrForbidden
+	}
+	return upload, nil
+}
+
+func (s *UploadService) complete(ctx *fiber.Ctx, ticket *domain.Ticket, upload *domain.AttachmentUpload, digest []byte) error {
+	if upload.Checksum != "" {
+		_, expected, err := parseChecksum(upload.Checksum)
+		if err != nil {
+			return err
+		}
+		if subtle.ConstantTimeCompare(expected, digest) != 1 {
+			// The received bytes can never match, the client has to start over
+			if err := s.discard(ctx, upload); err != nil {
+				return err
+			}
+			return domain.ErrChecksumMismatch
+		}
+	}
+
+	key, err := attachmentKey(upload.ProjectID, upload.TicketID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.quota.reserve(ctx, upload.Length); err != nil {
+		return err
+	}
+
+	content := &chunkReader{ctx: ctx.UserContext(), storage: s.storage, uploadID: upload.UploadID, count: upload.ChunkCount}
+	defer content.Close()
+
+	if err := s.storage.Put(ctx.UserContext(), key, content, upload.Length, upload.MimeType); err != nil {
+		s.quota.release(ctx, upload.Length)
+		return err
+	}
+
+	attachment := &domain.Attachment{
+		TicketID:   upload.TicketID,
+		Filename:   upload.Filename,
+		FilePath:   key,
+		FileSize:   upload.Length,
+		MimeType:   upload.MimeType,
+		UploadedBy: upload.UserID,
+	}
+	if err := s.generateThumbnails(ctx, attachment); err != nil {
+		s.storage.Delete(ctx.UserContext(), key)
+		s.quota.release(ctx, upload.Length)
+		return err
+	}
+	if err := s.upRepo.CompleteUpload(ctx, upload, attachment); err != nil {
+		s.storage.Delete(ctx.UserContext(), key)
+		s.thumbnails.remove(ctx, attachment.Thumbnails)
+		s.quota.release(ctx, upload.Length)
+		return err
+	}
+
+	s.deleteChunks(ctx.UserContext(), upload)
+
+	activity := newActivity(ticket, upload.UserID, domain.ActivityActionAdded, "attachment", nil, &attachment.Filename)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+// generateThumbnails reads a completed image back from storage to create its
+// thumbnails. Images larger than maxThumbnailSourceSize get none.
+func (s *UploadService) generateThumbnails(ctx *fiber.Ctx, attachment *domain.Attachment) error {
+	if !thumbnailMimeTypes[attachment.MimeType] || attachment.FileSize > maxThumbnailSourceSize {
+		return nil
+	}
+
+	file, err := s.storage.Get(ctx.UserContext(), attachment.FilePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return err
+	}
+
+	return s.thumbnails.generate(ctx, attachment, content)
+}
+
+func (s *UploadService) discard(ctx *fiber.Ctx, upload *domain.AttachmentUpload) error {
+	s.deleteChunks(ctx.UserContext(), upload)
+	return s.upRepo.DeleteUpload(ctx, upload.ID)
+}
+
+func (s *UploadService) deleteChunks(ctx context.Context, upload *domain.AttachmentUpload) {
+	deleteUploadChunks(ctx, s.storage, upload)
+}
+
+// deleteUploadChunks removes the chunks of an upload. Failures only leave
+// orphaned chunks behind, they do not fail the request.
+func deleteUploadChunks(ctx context.Context, storage port.FileStorage, upload *domain.AttachmentUpload) {
+	for i := 0; i < upload.ChunkCount; i++ {
+		storage.Delete(ctx, uploadChunkKey(upload.UploadID, i))
+	}
+}
+
+// UploadReaper periodically removes the unfinished uploads that expired, with
+// their chunks. Chunks do not count toward the storage quota, so they must not
+// outlive the upload.
+type UploadReaper struct {
+	upRepo   port.UploadRepository
+	storage  port.FileStorage
+	interval time.Duration
+}
+
+func NewUploadReaper(upRepo port.UploadRepository, storage port.FileStorage, interval time.Duration) *UploadReaper {
+	return &UploadReaper{upRepo: upRepo, storage: storage, interval: interval}
+}
+
+// Run reaps once at startup and then every interval until ctx is done
+func (r *UploadReaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Reap(ctx); err != nil {
+			log.Printf("[ERROR] Upload reap failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reap deletes the expired uploads, then their chunks. Deleting the upload
+// first keeps a late request from storing a chunk once they are removed.
+func (r *UploadReaper) Reap(ctx context.Context) error {
+	before := time.Now().Add(-uploadReapDelay)
+
+	for {
+		uploads, err := r.upRepo.GetExpiredUploads(ctx, before, uploadReapBatch)
+		if err != nil {
+			return err
+		}
+
+		for _, upload := range uploads {
+			deleted, err := r.upRepo.DeleteExpiredUpload(ctx, upload.ID, before)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				deleteUploadChunks(ctx, r.storage, upload)
+			}
+		}
+
+		if len(uploads) < uploadReapBatch {
+			return nil
+		}
+	}
+}
+
+func uploadChunkKey(uploadID string, index int) string {
+	return fmt.Sprintf("uploads/%s/%06d", uploadID, index)
+}
+
+// chunkReader reads the chunks of an upload one after the other, opening each
+// chunk only when the previous one is exhausted.
+type chunkReader struct {
+	ctx      context.Context
+	storage  port.FileStorage
+	uploadID string
+	count    int
+	index    int
+	current  io.ReadCloser
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if r.index >= r.count {
+				return 0, io.EOF
+			}
+			chunk, err := r.storage.Get(r.ctx, uploadChunkKey(r.uploadID, r.index))
+			if err != nil {
+				return 0, err
+			}
+			r.current = chunk
+			r.index++
+		}
+
+		n, err := r.current.Read(p)
+		if err == io.EOF {
+			r.current.Close()
+			r.current = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (r *chunkReader) Close() error {
+	if r.current != nil {
+		return r.current.Close()
+	}
+	return nil
+}
+
+// parseChecksum splits a tus checksum ("<algorithm> <base64 digest>").
+func parseChecksum(value string) (string, []byte, error) {
+	algorithm, encoded, found := strings.Cut(strings.TrimSpace(value), " ")
+	if !found {
+		return "", nil, errors.New("checksum must be \"<algorithm> <base64 digest>\"")
+	}
+
+	digest, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", nil, errors.New("checksum digest must be base64 encoded")
+	}
+
+	algorithm = strings.ToLower(algorithm)
+	if _, err := newChecksumHash(algorithm); err != nil {
+		return "", nil, err
+	}
+	return algorithm, digest, nil
+}
+
+func verifyChecksum(value string, content []byte) error {
+	algorithm, expected, err := parseChecksum(value)
+	if err != nil {
+		return err
+	}
+
+	h, _ := newChecksumHash(algorithm)
+	h.Write(content)
+	if subtle.ConstantTimeCompare(expected, h.Sum(nil)) != 1 {
+		return domain.ErrChecksumMismatch
+	}
+	return nil
+}
+
+func newChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case "md5":
+		return md5.New(), nil
+	case "sha1":
+		return sha1.New(), nil
+	case "sha256":
+		return sha256.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm %q", algorithm)
+	}
+}
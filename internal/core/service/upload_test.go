@@ -0,0 +1,70 @@
+package service
+
+import (
+	"errors"
+	"task-management/internal/core/domain"
+	"testing"
+)
+
+func TestParseChecksum(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		algorithm string
+		digest    int
+		wantErr   bool
+	}{
+		{name: "sha256", value: "sha256 LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=", algorithm: "sha256", digest: 32},
+		{name: "upper case algorithm", value: "SHA1 qvTGHdzF6KLavt4PO0gs2a6pQ00=", algorithm: "sha1", digest: 20},
+		{name: "surrounding spaces", value: " md5 XUFAKrxLKna5cZ2REBfFkg== ", algorithm: "md5", digest: 16},
+		{name: "missing digest", value: "sha256", wantErr: true},
+		{name: "invalid base64", value: "sha256 not-base64!", wantErr: true},
+		{name: "unsupported algorithm", value: "crc32 AAAAAA==", wantErr: true},
+		{name: "empty", value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			algorithm, digest, err := parseChecksum(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseChecksum(%q) accepted an invalid checksum", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseChecksum(%q) error = %v", tt.value, err)
+			}
+			if algorithm != tt.algorithm || len(digest) != tt.digest {
+				t.Fatalf("parseChecksum(%q) = %s with %d bytes, want %s with %d bytes", tt.value, algorithm, len(digest), tt.algorithm, tt.digest)
+			}
+		})
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		content string
+		wantErr error
+	}{
+		{name: "md5", value: "md5 XUFAKrxLKna5cZ2REBfFkg==", content: "hello"},
+		{name: "sha1", value: "sha1 qvTGHdzF6KLavt4PO0gs2a6pQ00=", content: "hello"},
+		{name: "sha256", value: "sha256 LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=", content: "hello"},
+		{name: "other content", value: "sha256 LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=", content: "hello!", wantErr: domain.ErrChecksumMismatch},
+		{name: "digest of another algorithm", value: "sha1 XUFAKrxLKna5cZ2REBfFkg==", content: "hello", wantErr: domain.ErrChecksumMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := verifyChecksum(tt.value, []byte(tt.content)); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("verifyChecksum() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := verifyChecksum("crc32 AAAAAA==", []byte("hello")); err == nil || errors.Is(err, domain.ErrChecksumMismatch) {
+		t.Fatalf("verifyChecksum() error = %v, want an unsupported algorithm error", err)
+	}
+}
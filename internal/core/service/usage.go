@@ -0,0 +1,50 @@
+package service
+
+import (
+	"errors"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// storageQuota keeps the attachment bytes of the current organization within
+// the storage quota of its plan.
+type storageQuota struct {
+	uRepo port.UsageRepository
+}
+
+// check fails when size more bytes would not fit in the quota. It does not
+// reserve anything, it only rejects uploads that can never complete early.
+func (q storageQuota) check(ctx *fiber.Ctx, size int64) error {
+	usage, err := q.uRepo.GetUsage(ctx, ctx.Locals("organization_id").(uint))
+	if err != nil {
+		return err
+	}
+	if usage.StorageQuota != nil && usage.StorageBytes+size > *usage.StorageQuota {
+		return storageQuotaError(usage)
+	}
+	return nil
+}
+
+func (q storageQuota) reserve(ctx *fiber.Ctx, size int64) error {
+	organizationID := ctx.Locals("organization_id").(uint)
+
+	usage, err := q.uRepo.GetUsage(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	err = q.uRepo.ReserveStorage(ctx, organizationID, size, usage.StorageQuota)
+	if errors.Is(err, domain.ErrStorageQuotaExceeded) {
+		return storageQuotaError(usage)
+	}
+	return err
+}
+
+func (q storageQuota) release(ctx *fiber.Ctx, size int64) error {
+	return q.uRepo.ReleaseStorage(ctx, ctx.Locals("organization_id").(uint), size)
+}
+
+func storageQuotaError(usage *domain.OrganizationUsage) error {
+	return &domain.EntitlementError{PlanType: usage.PlanType, Limit: domain.LimitStorage, Max: usage.StorageQuota}
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"strconv"
 	"task-management/internal/core/domain"
 	"task-management/internal/core/port"
 
@@ -29,4 +30,12 @@ func (s *UserService) UpdateUser(ctx *fiber.Ctx, id uint, user *domain.UpdateUse
 
 func (s *UserService) DeleteUser(ctx *fiber.Ctx, id uint) error {
 	return s.uRepo.DeleteUser(ctx, id)
-}
\ No newline at end of file
+}
+
+func (s *UserService) GetMyPreferences(ctx *fiber.Ctx) ([]*domain.UserPreference, error) {
+	return s.uRepo.GetPreferences(ctx, ctx.Locals("user_id").(uint))
+}
+
+func (s *UserService) UpdateAutoWatch(ctx *fiber.Ctx, req *domain.UpdateAutoWatchRequest) (*domain.UserPreference, error) {
+	return s.uRepo.SetPreference(ctx, ctx.Locals("user_id").(uint), domain.PreferenceAutoWatch, strconv.FormatBool(*req.Enabled))
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type VersionService struct {
+	vRepo port.VersionRepository
+	tRepo port.TicketRepository
+	aRepo port.ActivityRepository
+}
+
+func NewVersionService(vRepo port.VersionRepository, tRepo port.TicketRepository, aRepo port.ActivityRepository) *VersionService {
+	return &VersionService{vRepo: vRepo, tRepo: tRepo, aRepo: aRepo}
+}
+
+func (s *VersionService) GetVersions(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.Version, error) {
+	return s.vRepo.GetVersions(ctx, projectID)
+}
+
+func (s *VersionService) GetVersionByID(ctx *fiber.Ctx, projectID uint, id uint) (*domain.Version, error) {
+	return s.vRepo.GetVersionByID(ctx, projectID, id)
+}
+
+// CreateVersion adds a version to the project. Names are unique per project
+// ignoring case.
+func (s *VersionService) CreateVersion(ctx *fiber.Ctx, projectID uint, req *domain.CreateVersionRequest) (*domain.Version, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.New("name must not be blank")
+	}
+
+	if err := s.checkNameAvailable(ctx, projectID, 0, name); err != nil {
+		return nil, err
+	}
+
+	version := &domain.Version{
+		ProjectID:   projectID,
+		Name:        name,
+		Description: req.Description,
+		ReleaseDate: req.ReleaseDate,
+	}
+	if err := s.vRepo.CreateVersion(ctx, version); err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+func (s *VersionService) UpdateVersion(ctx *fiber.Ctx, projectID uint, id uint, req *domain.UpdateVersionRequest) (*domain.Version, error) {
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, errors.New("name must not be blank")
+		}
+		if err := s.checkNameAvailable(ctx, projectID, id, name); err != nil {
+			return nil, err
+		}
+		req.Name = &name
+	}
+
+	return s.vRepo.UpdateVersion(ctx, projectID, id, req)
+}
+
+func (s *VersionService) DeleteVersion(ctx *fiber.Ctx, projectID uint, id uint) error {
+	return s.vRepo.DeleteVersion(ctx, projectID, id)
+}
+
+// ReleaseVersion marks the version released. Tickets fixed in it that are not
+// resolved yet move to the requested version or to the next unreleased one, a
+// release that would leave them behind is refused.
+func (s *VersionService) ReleaseVersion(ctx *fiber.Ctx, projectID uint, id uint, req *domain.ReleaseVersionRequest) (*domain.ReleaseResult, error) {
+	userID := ctx.Locals("user_id").(uint)
+
+	version, err := s.vRepo.GetVersionByID(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+	if version.IsReleased {
+		return nil, domain.ErrVersionReleased
+	}
+
+	var next *domain.Version
+	if req.MoveToVersionID != nil {
+		if *req.MoveToVersionID == id {
+			return nil, errors.New("tickets cannot be moved to the version being released")
+		}
+		next, err = s.vRepo.GetVersionByID(ctx, projectID, *req.MoveToVersionID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("target version not found in this project")
+			}
+			return nil, err
+		}
+		if next.IsReleased {
+			return nil, errors.New("tickets cannot be moved to a released version")
+		}
+	} else {
+		next, err = s.vRepo.GetNextVersion(ctx, projectID, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var nextID *uint
+	if next != nil {
+		nextID = &next.ID
+	}
+
+	releaseDate := time.Now()
+	if req.ReleaseDate != nil {
+		releaseDate = *req.ReleaseDate
+	}
+
+	moved, err := s.vRepo.ReleaseVersion(ctx, projectID, id, nextID, releaseDate, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	activities := make([]*domain.TicketActivity, len(moved))
+	for i, ticketID := range moved {
+		ticket := &domain.Ticket{ID: ticketID, ProjectID: projectID}
+		activities[i] = newActivity(ticket, userID, domain.ActivityActionUpdated, "fix_version", &version.Name, &next.Name)
+	}
+	if err := s.aRepo.CreateActivities(ctx, activities); err != nil {
+		return nil, err
+	}
+
+	released, err := s.vRepo.GetVersionByID(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.ReleaseResult{Version: released, MovedTicketIDs: moved}
+	if result.MovedTicketIDs == nil {
+		result.MovedTicketIDs = []uint{}
+	}
+	if len(moved) > 0 {
+		result.MovedTo = next
+	}
+	return result, nil
+}
+
+// GetReleaseNotes groups the resolved tickets fixed in the version by type, in
+// the ticket type order.
+func (s *VersionService) GetReleaseNotes(ctx *fiber.Ctx, projectID uint, id uint) (*domain.ReleaseNotes, error) {
+	version, err := s.vRepo.GetVersionByID(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	tickets, err := s.vRepo.GetReleaseNotesTickets(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	notes := &domain.ReleaseNotes{Version: version, Sections: []*domain.ReleaseNotesSection{}}
+	var section *domain.ReleaseNotesSection
+	for _, ticket := range tickets {
+		typeName := "Other"
+		if ticket.Type != nil {
+			typeName = ticket.Type.Name
+		}
+		if section == nil || section.Type != typeName {
+			section = &domain.ReleaseNotesSection{Type: typeName}
+			notes.Sections = append(notes.Sections, section)
+		}
+		section.Tickets = append(section.Tickets, &domain.ReleaseNotesItem{
+			ID:        ticket.ID,
+			TicketKey: ticket.TicketKey,
+			Title:     ticket.Title,
+		})
+	}
+
+	return notes, nil
+}
+
+func (s *VersionService) AddTicketVersion(ctx *fiber.Ctx, projectID uint, ticketID uint, req *domain.AddTicketVersionRequest) error {
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+
+	version, err := s.vRepo.GetVersionByID(ctx, projectID, req.VersionID)
+	if err != nil {
+		return err
+	}
+	if !version.IsActive {
+		return errors.New("version is archived")
+	}
+
+	added, err := s.vRepo.AddTicketVersion(ctx, ticket.ID, version.ID, req.Relation)
+	if err != nil || !added {
+		return err
+	}
+
+	activity := newActivity(ticket, ctx.Locals("user_id").(uint), domain.ActivityActionAdded, versionField(req.Relation), nil, &version.Name)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+func (s *VersionService) RemoveTicketVersion(ctx *fiber.Ctx, projectID uint, ticketID uint, versionID uint, relation string) error {
+	if relation != domain.VersionRelationFix && relation != domain.VersionRelationAffects {
+		return errors.New("relation must be fix or affects")
+	}
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+
+	version, err := s.vRepo.GetVersionByID(ctx, projectID, versionID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.vRepo.RemoveTicketVersion(ctx, ticket.ID, version.ID, relation); err != nil {
+		return err
+	}
+
+	activity := newActivity(ticket, ctx.Locals("user_id").(uint), domain.ActivityActionRemoved, versionField(relation), &version.Name, nil)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+// checkNameAvailable fails when another version of the project (not id) has
+// the name in any case.
+func (s *VersionService) checkNameAvailable(ctx *fiber.Ctx, projectID uint, id uint, name string) error {
+	existing, err := s.vRepo.FindVersionByName(ctx, projectID, name)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != id {
+		return domain.ErrVersionExists
+	}
+	return nil
+}
+
+// versionField is the activity field of a ticket version relation
+func versionField(relation string) string {
+	return relation + "_version"
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WatcherService struct {
+	wRepo port.WatcherRepository
+	tRepo port.TicketRepository
+	aRepo port.ActivityRepository
+}
+
+func NewWatcherService(wRepo port.WatcherRepository, tRepo port.TicketRepository, aRepo port.ActivityRepository) *WatcherService {
+	return &WatcherService{wRepo: wRepo, tRepo: tRepo, aRepo: aRepo}
+}
+
+func (s *WatcherService) GetWatchers(ctx *fiber.Ctx, projectID uint, ticketID uint) ([]*domain.User, error) {
+	if _, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID); err != nil {
+		return nil, err
+	}
+	return s.wRepo.GetWatchers(ctx, ticketID)
+}
+
+// Watch adds the current user to the watchers. Explicitly watching a ticket
+// ignores the auto_watch preference.
+func (s *WatcherService) Watch(ctx *fiber.Ctx, projectID uint, ticketID uint) error {
+	userID := ctx.Locals("user_id").(uint)
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+
+	added, err := s.wRepo.AddWatchers(ctx, ticket.ID, []uint{userID})
+	if err != nil || len(added) == 0 {
+		return err
+	}
+
+	activity := newActivity(ticket, userID, domain.ActivityActionAdded, "watcher", nil, formatID(&userID))
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+func (s *WatcherService) Unwatch(ctx *fiber.Ctx, projectID uint, ticketID uint) error {
+	userID := ctx.Locals("user_id").(uint)
+
+	ticket, err := s.tRepo.GetTicketByID(ctx, projectID, ticketID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.wRepo.RemoveWatcher(ctx, ticket.ID, userID); err != nil {
+		return err
+	}
+
+	activity := newActivity(ticket, userID, domain.ActivityActionRemoved, "watcher", formatID(&userID), nil)
+	return s.aRepo.CreateActivities(ctx, []*domain.TicketActivity{activity})
+}
+
+// autoWatcher adds the users involved in a ticket (reporter, assignee,
+// commenters, mentioned users) as watchers unless they opted out.
+type autoWatcher struct {
+	wRepo port.WatcherRepository
+	aRepo port.ActivityRepository
+}
+
+func (w *autoWatcher) add(ctx *fiber.Ctx, ticket *domain.Ticket, userIDs ...uint) error {
+	seen := map[uint]bool{}
+	candidates := []uint{}
+	for _, userID := range userIDs {
+		if userID == 0 || seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		candidates = append(candidates, userID)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	optOuts, err := w.wRepo.GetAutoWatchOptOuts(ctx, candidates)
+	if err != nil {
+		return err
+	}
+	for _, userID := range optOuts {
+		seen[userID] = false
+	}
+
+	watchers := []uint{}
+	for _, userID := range candidates {
+		if seen[userID] {
+			watchers = append(watchers, userID)
+		}
+	}
+	if len(watchers) == 0 {
+		return nil
+	}
+
+	added, err := w.wRepo.AddWatchers(ctx, ticket.ID, watchers)
+	if err != nil {
+		return err
+	}
+
+	actorID := ctx.Locals("user_id").(uint)
+	activities := make([]*domain.TicketActivity, len(added))
+	for i, watcherID := range added {
+		activities[i] = newActivity(ticket, actorID, domain.ActivityActionAdded, "watcher", nil, formatID(&watcherID))
+	}
+	return w.aRepo.CreateActivities(ctx, activities)
+}
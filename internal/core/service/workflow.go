@@ -0,0 +1,76 @@
+package service
+
+import (
+	"errors"
+	"task-management/internal/core/domain"
+	"task-management/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WorkflowService struct {
+	wRepo        port.WorkflowRepository
+	tRepo        port.TicketRepository
+	entitlements port.EntitlementService
+}
+
+func NewWorkflowService(wRepo port.WorkflowRepository, tRepo port.TicketRepository, entitlements port.EntitlementService) *WorkflowService {
+	return &WorkflowService{wRepo: wRepo, tRepo: tRepo, entitlements: entitlements}
+}
+
+func (s *WorkflowService) GetTransitions(ctx *fiber.Ctx, projectID uint) (int64, int64, int64, []*domain.WorkflowTransition, error) {
+	return s.wRepo.GetTransitions(ctx, projectID)
+}
+
+// CreateTransition adds a transition to the workflow of the project. Custom
+// workflows depend on the plan, existing transitions can always be deleted.
+func (s *WorkflowService) CreateTransition(ctx *fiber.Ctx, projectID uint, req *domain.CreateWorkflowTransitionRequest) (*domain.WorkflowTransition, error) {
+	if err := s.entitlements.CheckFeature(ctx, domain.FeatureCustomWorkflows); err != nil {
+		return nil, err
+	}
+
+	if req.FromStatusID != nil && *req.FromStatusID == req.ToStatusID {
+		return nil, errors.New("a transition must change the status")
+	}
+
+	if _, err := s.tRepo.GetTicketStatusByID(ctx, projectID, req.ToStatusID); err != nil {
+		return nil, errors.New("target status does not belong to this project")
+	}
+
+	if req.FromStatusID != nil {
+		if _, err := s.tRepo.GetTicketStatusByID(ctx, projectID, *req.FromStatusID); err != nil {
+			return nil, errors.New("source status does not belong to this project")
+		}
+	}
+
+	if req.TypeID != nil {
+		if _, err := s.tRepo.GetTicketTypeByID(ctx, *req.TypeID); err != nil {
+			return nil, errors.New("ticket type not found")
+		}
+	}
+
+	transition := &domain.WorkflowTransition{
+		ProjectID:          projectID,
+		TypeID:             req.TypeID,
+		FromStatusID:       req.FromStatusID,
+		ToStatusID:         req.ToStatusID,
+		Name:               req.Name,
+		RequireResolution:  req.RequireResolution,
+		RequireAssignee:    req.RequireAssignee,
+		RequireManageTasks: req.RequireManageTasks,
+	}
+
+	if err := s.wRepo.CreateTransition(ctx, transition); err != nil {
+		return nil, err
+	}
+
+	return transition, nil
+}
+
+func (s *WorkflowService) DeleteTransition(ctx *fiber.Ctx, projectID uint, id uint) error {
+	return s.wRepo.DeleteTransition(ctx, projectID, id)
+}
+
+func (s *WorkflowService) GetResolutions(ctx *fiber.Ctx) ([]*domain.Resolution, error) {
+	return s.wRepo.GetResolutions(ctx)
+}
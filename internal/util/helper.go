@@ -152,6 +152,16 @@ func UpdateOne[M any](c *fiber.Ctx, db *gorm.DB, id int64, data interface{}, pre
 	return &Models, nil
 }
 
+// reservedParams are not column filters, they are handled by FindAll itself or
+// by the repository calling it.
+var reservedParams = map[string]bool{
+	"page":       true,
+	"limit":      true,
+	"sort_by":    true,
+	"sort_order": true,
+	"label_ids":  true,
+}
+
 func queryParams(query *gorm.DB, c *fiber.Ctx) *gorm.DB {
 	queryParams := c.Queries()
 
@@ -188,7 +198,7 @@ func queryParams(query *gorm.DB, c *fiber.Ctx) *gorm.DB {
 				}
 			}
 
-		case key != "page" && key != "limit" && key != "sort_by" && key != "sort_order":
+		case !reservedParams[key]:
 			if len(values) == 1 {
 				if strings.ToLower(values[0]) == "null" {
 					query = query.Where(fmt.Sprintf("%s IS NULL", key))
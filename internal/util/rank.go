@@ -0,0 +1,139 @@
+package util
+
+import "strings"
+
+// Ticket ranks are strings of base 36 digits compared byte by byte. A rank
+// never ends with the smallest digit so there is always room for another rank
+// before it.
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// RankBetween returns a rank strictly between prev and next. An empty prev is
+// the start of the list and an empty next its end, prev must sort before next.
+// Repeated inserts at the same place make ranks longer until they are
+// rebalanced.
+func RankBetween(prev string, next string) string {
+	switch {
+	case prev == "" && next == "":
+		return EvenRanks(1)[0]
+	case next == "":
+		return rankAfter(prev)
+	case prev == "":
+		return rankBefore(next)
+	}
+	return rankMidpoint(prev, next)
+}
+
+// rankMidpoint halves the range between prev and next, an empty next is the
+// end of the range.
+func rankMidpoint(prev string, next string) string {
+	if next != "" {
+		// Keep the common prefix, prev is padded with the smallest digit
+		n := 0
+		for n < len(next) && rankDigitAt(prev, n) == next[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(prev) {
+				rest = prev[n:]
+			}
+			return next[:n] + rankMidpoint(rest, next[n:])
+		}
+	}
+
+	digitPrev := 0
+	if prev != "" {
+		digitPrev = strings.IndexByte(rankDigits, prev[0])
+	}
+	digitNext := len(rankDigits)
+	if next != "" {
+		digitNext = strings.IndexByte(rankDigits, next[0])
+	}
+
+	if digitNext-digitPrev > 1 {
+		return string(rankDigits[(digitPrev+digitNext+1)/2])
+	}
+
+	// The first digits are consecutive, a longer next can be cut to its first
+	// digit, otherwise keep prev's digit and go between the rest of prev and the end
+	if len(next) > 1 {
+		return next[:1]
+	}
+	rest := ""
+	if len(prev) > 1 {
+		rest = prev[1:]
+	}
+	return string(rankDigits[digitPrev]) + rankMidpoint(rest, "")
+}
+
+// rankAfter returns a rank after prev at the end of the list. Tickets are
+// mostly appended there, so the last digit is incremented instead of halving
+// the remaining range, which keeps the ranks short.
+func rankAfter(prev string) string {
+	buf := []byte(prev)
+	for i := len(buf) - 1; i >= 0; i-- {
+		digit := strings.IndexByte(rankDigits, buf[i])
+		if digit < len(rankDigits)-1 {
+			buf[i] = rankDigits[digit+1]
+			if buf[len(buf)-1] == rankDigits[0] {
+				buf[len(buf)-1] = rankDigits[1]
+			}
+			return string(buf)
+		}
+		buf[i] = rankDigits[0]
+	}
+	// Every digit is the largest one
+	return prev + string(rankDigits[len(rankDigits)/2])
+}
+
+// rankBefore returns a rank before next at the start of the list by
+// decrementing its last digit, which is never the smallest one.
+func rankBefore(next string) string {
+	buf := []byte(next)
+	last := len(buf) - 1
+	buf[last] = rankDigits[strings.IndexByte(rankDigits, buf[last])-1]
+	if buf[last] == rankDigits[0] {
+		buf = append(buf, rankDigits[len(rankDigits)-1])
+	}
+	return string(buf)
+}
+
+// EvenRanks returns n increasing ranks of the same length spread over the
+// middle half of the range, leaving room for several inserts between
+// neighbours and at both ends of the list.
+func EvenRanks(n int) []string {
+	// Two extra digits leave a gap of at least 36^2/2 between ranks
+	width, capacity := 2, len(rankDigits)*len(rankDigits)
+	for capacity/len(rankDigits) <= n {
+		width++
+		capacity *= len(rankDigits)
+	}
+	step := capacity / 2 / (n + 1)
+
+	ranks := make([]string, n)
+	for i := range ranks {
+		rank := encodeRank(capacity/4+(i+1)*step, width)
+		if rank[len(rank)-1] == rankDigits[0] {
+			// Still sorts before the next rank, which differs within width
+			rank += string(rankDigits[len(rankDigits)/2])
+		}
+		ranks[i] = rank
+	}
+	return ranks
+}
+
+func encodeRank(value int, width int) string {
+	buf := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		buf[i] = rankDigits[value%len(rankDigits)]
+		value /= len(rankDigits)
+	}
+	return string(buf)
+}
+
+func rankDigitAt(rank string, i int) byte {
+	if i < len(rank) {
+		return rank[i]
+	}
+	return rankDigits[0]
+}
@@ -0,0 +1,131 @@
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		prev string
+		next string
+		want string
+	}{
+		{name: "empty list", prev: "", next: "", want: "i0i"},
+		{name: "append", prev: "hi", next: "", want: "hj"},
+		{name: "append carries", prev: "hz", next: "", want: "i1"},
+		{name: "append after largest", prev: "zz", next: "", want: "zzi"},
+		{name: "prepend", prev: "", next: "hi", want: "hh"},
+		{name: "prepend before smallest digit", prev: "", next: "h1", want: "h0z"},
+		{name: "middle", prev: "a", next: "c", want: "b"},
+		{name: "wide range", prev: "1", next: "z", want: "i"},
+		{name: "consecutive digits", prev: "a", next: "b", want: "ai"},
+		{name: "common prefix", prev: "ha", next: "hc", want: "hb"},
+		{name: "longer next", prev: "a", next: "b5", want: "b"},
+		{name: "prev padded", prev: "h", next: "h5", want: "h3"},
+		{name: "largest digit before next", prev: "az", next: "b", want: "azi"},
+		{name: "adjacent last digits", prev: "a1", next: "a2", want: "a1i"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RankBetween(tt.prev, tt.next)
+			if got != tt.want {
+				t.Fatalf("RankBetween(%q, %q) = %q, want %q", tt.prev, tt.next, got, tt.want)
+			}
+			checkRankBetween(t, tt.prev, got, tt.next)
+		})
+	}
+}
+
+func TestRankBetweenRepeatedInserts(t *testing.T) {
+	tests := []struct {
+		name      string
+		afterPrev bool
+	}{
+		{name: "right after prev", afterPrev: true},
+		{name: "right before next", afterPrev: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev, next := "a", "b"
+			for i := 0; i < 200; i++ {
+				rank := RankBetween(prev, next)
+				checkRankBetween(t, prev, rank, next)
+				if tt.afterPrev {
+					next = rank
+				} else {
+					prev = rank
+				}
+			}
+		})
+	}
+
+	t.Run("at both ends", func(t *testing.T) {
+		first, last := "hi", "hi"
+		for i := 0; i < 200; i++ {
+			before, after := RankBetween("", first), RankBetween(last, "")
+			checkRankBetween(t, "", before, first)
+			checkRankBetween(t, last, after, "")
+			first, last = before, after
+		}
+	})
+}
+
+func TestEvenRanks(t *testing.T) {
+	tests := []struct {
+		n     int
+		width int
+	}{
+		{n: 1, width: 2},
+		{n: 2, width: 2},
+		{n: 35, width: 2},
+		{n: 36, width: 3},
+		{n: 1000, width: 3},
+		{n: 1296, width: 4},
+	}
+
+	for _, tt := range tests {
+		ranks := EvenRanks(tt.n)
+		if len(ranks) != tt.n {
+			t.Fatalf("EvenRanks(%d) returned %d ranks", tt.n, len(ranks))
+		}
+		for i, rank := range ranks {
+			if len(rank) < tt.width || len(rank) > tt.width+1 {
+				t.Fatalf("EvenRanks(%d)[%d] = %q, want %d digits", tt.n, i, rank, tt.width)
+			}
+			prev := ""
+			if i > 0 {
+				prev = ranks[i-1]
+			}
+			checkRankBetween(t, prev, rank, "")
+		}
+
+		// There is room at both ends and between neighbours
+		checkRankBetween(t, "", RankBetween("", ranks[0]), ranks[0])
+		checkRankBetween(t, ranks[tt.n-1], RankBetween(ranks[tt.n-1], ""), "")
+		for i := 1; i < tt.n; i++ {
+			checkRankBetween(t, ranks[i-1], RankBetween(ranks[i-1], ranks[i]), ranks[i])
+		}
+	}
+}
+
+// checkRankBetween fails unless rank sorts strictly between prev and next,
+// which are open ends when empty, and does not end with the smallest digit
+func checkRankBetween(t *testing.T, prev string, rank string, next string) {
+	t.Helper()
+	if rank == "" || strings.Trim(rank, rankDigits) != "" {
+		t.Fatalf("rank %q is not made of rank digits", rank)
+	}
+	if rank[len(rank)-1] == rankDigits[0] {
+		t.Fatalf("rank %q ends with the smallest digit", rank)
+	}
+	if prev != "" && rank <= prev {
+		t.Fatalf("rank %q does not sort after %q", rank, prev)
+	}
+	if next != "" && rank >= next {
+		t.Fatalf("rank %q does not sort before %q", rank, next)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	gormOrm "task-management/internal/adapter/storage/gorm"
 	"task-management/internal/adapter/storage/gorm/models"
 	"task-management/internal/adapter/storage/gorm/views"
+	"task-management/internal/util"
 
 	"github.com/fatih/color"
 )
@@ -67,15 +68,45 @@ func main() {
 		printSuccess(fmt.Sprintf("Created view: %s", name))
 	}
 
+	createNameIndexes()
+	fmt.Println()
 	upsertDefaultOrganization()
 	fmt.Println()
 	upsertDefaultProject()
 	fmt.Println()
 	upsertDefaultPriority()
+	fmt.Println()
+	upsertDefaultTicket()
+	fmt.Println()
+	backfillTicketCounters()
+	fmt.Println()
+	backfillTicketStatusHistory()
+	fmt.Println()
+	backfillOrganizationUsage()
+	fmt.Println()
+	backfillTicketRanks()
 
 	printHeader("Migration Completed Successfully!")
 }
 
+// createNameIndexes makes names unique per project in any case, which gorm
+// tags cannot express. Deleted rows are left out so a name can be reused.
+func createNameIndexes() {
+	printInfo("Creating case-insensitive name indexes...")
+
+	indexes := map[string]string{
+		"idx_labels_project_lower_name":   "labels",
+		"idx_versions_project_lower_name": "versions",
+	}
+	for name, table := range indexes {
+		query := fmt.Sprintf("CREATE UNIQUE INDEX IF NOT EXISTS %s ON %s (project_id, LOWER(name)) WHERE deleted_at IS NULL", name, table)
+		if err := gormOrm.Trx.Exec(query).Error; err != nil {
+			log.Fatalf("%s failed to create index %s, merge the duplicate names first: %v", red("[x]"), name, err)
+		}
+		printSuccess(fmt.Sprintf("Created index: %s", name))
+	}
+}
+
 func upsertDefaultOrganization() {
 	// Seed data for OrganizationStatus
 	organizationStatuses := []*models.OrganizationStatus{
@@ -470,3 +501,177 @@ func upsertDefaultPriority() {
 	}
 	printSuccess(fmt.Sprintf("Created %d priorities", len(priorities)))
 }
+
+func upsertDefaultTicket() {
+	// Seed data for TicketType
+	ticketTypes := []*models.TicketType{
+		{
+			BaseModel:      models.BaseModel{ID: 1},
+			Name:           "Task",
+			Description:    "A piece of work that needs to be done",
+			Icon:           "task",
+			Color:          "#4BADE8",
+			IsActive:       true,
+			Position:       1,
+			HierarchyLevel: 2,
+		},
+		{
+			BaseModel:      models.BaseModel{ID: 2},
+			Name:           "Bug",
+			Description:    "A problem that impairs or prevents the functions of the product",
+			Icon:           "bug",
+			Color:          "#E5493A",
+			IsActive:       true,
+			Position:       2,
+			HierarchyLevel: 2,
+		},
+		{
+			BaseModel:      models.BaseModel{ID: 3},
+			Name:           "Story",
+			Description:    "A feature expressed as a user goal",
+			Icon:           "story",
+			Color:          "#63BA3C",
+			IsActive:       true,
+			Position:       3,
+			HierarchyLevel: 2,
+		},
+		{
+			BaseModel:      models.BaseModel{ID: 4},
+			Name:           "Epic",
+			Description:    "A large body of work that can be broken down into stories",
+			Icon:           "epic",
+			Color:          "#904EE2",
+			IsActive:       true,
+			Position:       4,
+			HierarchyLevel: 3,
+		},
+		{
+			BaseModel:      models.BaseModel{ID: 5},
+			Name:           "Subtask",
+			Description:    "A smaller piece of work that is part of a larger ticket",
+			Icon:           "subtask",
+			Color:          "#4BADE8",
+			IsActive:       true,
+			Position:       5,
+			HierarchyLevel: 1,
+		},
+	}
+
+	// Seed data for Resolution
+	resolutions := []*models.Resolution{
+		{
+			BaseModel:   models.BaseModel{ID: 1},
+			Name:        "Done",
+			Description: "Work has been completed",
+			IsDefault:   true,
+		},
+		{
+			BaseModel:   models.BaseModel{ID: 2},
+			Name:        "Won't Do",
+			Description: "The ticket will not be worked on",
+		},
+		{
+			BaseModel:   models.BaseModel{ID: 3},
+			Name:        "Duplicate",
+			Description: "The problem is a duplicate of an existing ticket",
+		},
+		{
+			BaseModel:   models.BaseModel{ID: 4},
+			Name:        "Cannot Reproduce",
+			Description: "The problem could not be reproduced",
+		},
+	}
+
+	printInfo("Seeding ticket types...")
+	// Use upsert instead of delete to avoid foreign key constraints
+	if err := gormOrm.Trx.Save(ticketTypes).Error; err != nil {
+		log.Fatalf("%s failed to upsert ticket types: %v", red("[x]"), err)
+	}
+	if err := gormOrm.Trx.Save(resolutions).Error; err != nil {
+		log.Fatalf("%s failed to upsert resolutions: %v", red("[x]"), err)
+	}
+
+	printSuccess(fmt.Sprintf("Created %d ticket types", len(ticketTypes)))
+	printSuccess(fmt.Sprintf("Created %d resolutions", len(resolutions)))
+}
+
+func backfillTicketCounters() {
+	printInfo("Backfilling ticket numbers and project ticket counters...")
+
+	// Tickets created before per-project numbering use the number in their key
+	if err := gormOrm.Trx.Exec(`update tickets set ticket_number = split_part(ticket_key, '-', 2)::bigint
+where ticket_number = 0 and split_part(ticket_key, '-', 2) ~ '^[0-9]+$'`).Error; err != nil {
+		log.Fatalf("%s failed to backfill ticket numbers: %v", red("[x]"), err)
+	}
+
+	// Counters must never issue a number that an existing (or deleted) ticket already uses
+	if err := gormOrm.Trx.Exec(`update projects p set ticket_counter = t.max_number
+from (select project_id, max(ticket_number) as max_number from tickets group by project_id) t
+where t.project_id = p.id and p.ticket_counter < t.max_number`).Error; err != nil {
+		log.Fatalf("%s failed to backfill ticket counters: %v", red("[x]"), err)
+	}
+
+	printSuccess("Ticket counters are up to date")
+}
+
+func backfillTicketStatusHistory() {
+	printInfo("Backfilling ticket status history...")
+
+	// Tickets created before history was recorded start with their current status
+	result := gormOrm.Trx.Exec(`insert into ticket_status_histories (ticket_id, project_id, to_status_id, changed_by, changed_at, created_at, updated_at)
+select t.id, t.project_id, t.status_id, t.reporter_id, t.created_at, now(), now() from tickets t
+where not exists (select 1 from ticket_status_histories h where h.ticket_id = t.id)`)
+	if result.Error != nil {
+		log.Fatalf("%s failed to backfill ticket status history: %v", red("[x]"), result.Error)
+	}
+
+	printSuccess(fmt.Sprintf("Backfilled status history for %d tickets", result.RowsAffected))
+}
+
+func backfillOrganizationUsage() {
+	printInfo("Backfilling organization storage usage...")
+
+	// Usage is recomputed from the attachments that still exist and their thumbnails
+	result := gormOrm.Trx.Exec(`insert into organization_usages (organization_id, storage_bytes, updated_at)
+select p.organization_id, coalesce(sum(a.file_size + coalesce((select sum(th.file_size) from attachment_thumbnails th
+	where th.attachment_id = a.id and th.deleted_at is null), 0)), 0), now() from ticket_attachments a
+join tickets t on t.id = a.ticket_id
+join projects p on p.id = t.project_id
+where a.deleted_at is null and p.organization_id is not null
+group by p.organization_id
+on conflict (organization_id) do update set storage_bytes = excluded.storage_bytes, updated_at = now()`)
+	if result.Error != nil {
+		log.Fatalf("%s failed to backfill organization usage: %v", red("[x]"), result.Error)
+	}
+
+	printSuccess(fmt.Sprintf("Backfilled storage usage for %d organizations", result.RowsAffected))
+}
+
+func backfillTicketRanks() {
+	printInfo("Backfilling ticket ranks...")
+
+	var projectIDs []uint
+	if err := gormOrm.Trx.Model(&models.Ticket{}).Where("rank = ''").Distinct().Pluck("project_id", &projectIDs).Error; err != nil {
+		log.Fatalf("%s failed to find unranked tickets: %v", red("[x]"), err)
+	}
+
+	// Unranked tickets keep their creation order after the ranked ones
+	for _, projectID := range projectIDs {
+		var ticketIDs []uint
+		err := gormOrm.Trx.Model(&models.Ticket{}).
+			Where("project_id = ?", projectID).
+			Order("rank = '' asc, rank asc, id asc").
+			Pluck("id", &ticketIDs).Error
+		if err != nil {
+			log.Fatalf("%s failed to load tickets of project %d: %v", red("[x]"), projectID, err)
+		}
+
+		for i, rank := range util.EvenRanks(len(ticketIDs)) {
+			if err := gormOrm.Trx.Model(&models.Ticket{}).Where("id = ?", ticketIDs[i]).UpdateColumn("rank", rank).Error; err != nil {
+				log.Fatalf("%s failed to rank ticket %d: %v", red("[x]"), ticketIDs[i], err)
+			}
+		}
+	}
+
+	printSuccess(fmt.Sprintf("Ranked the tickets of %d projects", len(projectIDs)))
+}